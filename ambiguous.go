@@ -0,0 +1,209 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FieldOrder is the order of the year, month and day in numeric date input.
+type FieldOrder int
+
+const (
+	YMD FieldOrder = iota
+	DMY
+	MDY
+)
+
+func (o FieldOrder) String() string {
+	switch o {
+	case YMD:
+		return "YMD"
+	case DMY:
+		return "DMY"
+	case MDY:
+		return "MDY"
+	}
+
+	return fmt.Sprintf("FieldOrder(%d)", int(o))
+}
+
+// indexes returns the positions of the year, month and day fields.
+func (o FieldOrder) indexes() (year, month, day int) {
+	switch o {
+	case DMY:
+		return 2, 1, 0
+	case MDY:
+		return 2, 0, 1
+	}
+
+	return 0, 1, 2
+}
+
+var mdyRegions = []string{"US", "PH", "FM", "MH", "PW", "BZ"}
+
+var ymdLanguages = []string{"ja", "zh", "ko", "hu", "lt", "mn"}
+
+// LocaleOrders returns the field orders plausible for a BCP 47 language tag.
+// ISO 8601 order (YMD) is always included, so a two-digit year can still make
+// input ambiguous.
+func LocaleOrders(tag string) []FieldOrder {
+	parts := strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")
+	for _, part := range parts[1:] {
+		for _, region := range mdyRegions {
+			if strings.EqualFold(part, region) {
+				return []FieldOrder{MDY, YMD}
+			}
+		}
+	}
+
+	for _, lang := range ymdLanguages {
+		if strings.EqualFold(parts[0], lang) {
+			return []FieldOrder{YMD}
+		}
+	}
+
+	return []FieldOrder{DMY, YMD}
+}
+
+// AmbiguousParser parses numeric dates such as "03/04/2026", "3.4.26" or
+// "2026-04-03" whose field order depends on the writer's locale. Fields may
+// be separated by "/", "-", "." or spaces, or be written together as eight
+// digits.
+type AmbiguousParser struct {
+	// Orders lists the field orders to try, most preferred first. An empty
+	// list tries YMD, DMY and MDY.
+	Orders []FieldOrder
+	// PivotYear is the first year of the 100-year window two-digit years are
+	// placed in; with 1950, "49" is 2049 and "50" is 1950. Zero uses the
+	// window 1969-2068, like the time package.
+	PivotYear int
+}
+
+// AmbiguousError is returned when input has more than one valid reading.
+// Candidates are ordered by the parser's order preference.
+type AmbiguousError struct {
+	Value      string
+	Candidates []Date
+}
+
+func (e *AmbiguousError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		candidates[i] = c.String()
+	}
+
+	return fmt.Sprintf("date %q is ambiguous: could be %s", e.Value, strings.Join(candidates, ", "))
+}
+
+// Parse returns the date if value has exactly one valid reading, and an
+// *AmbiguousError listing every candidate if it has more.
+func (p AmbiguousParser) Parse(value string) (Date, error) {
+	candidates, err := p.Candidates(value)
+	if err != nil {
+		return Date{}, err
+	}
+
+	if len(candidates) > 1 {
+		return Date{}, &AmbiguousError{Value: value, Candidates: candidates}
+	}
+
+	return candidates[0], nil
+}
+
+// Candidates returns every distinct valid reading of value, ordered by the
+// parser's order preference. It returns an error if there is none.
+func (p AmbiguousParser) Candidates(value string) ([]Date, error) {
+	orders := p.Orders
+	if len(orders) == 0 {
+		orders = []FieldOrder{YMD, DMY, MDY}
+	}
+
+	var candidates []Date
+	for _, order := range orders {
+		fields, ok := splitFields(strings.TrimSpace(value), order)
+		if !ok {
+			continue
+		}
+
+		d, ok := p.resolve(fields, order)
+		if !ok {
+			continue
+		}
+
+		duplicate := false
+		for _, c := range candidates {
+			duplicate = duplicate || c.Equal(d)
+		}
+		if !duplicate {
+			candidates = append(candidates, d)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, &ParseError{Value: value, Message: fmt.Sprintf("no valid reading in order %v", orders)}
+	}
+
+	return candidates, nil
+}
+
+func (p AmbiguousParser) resolve(fields [3]string, order FieldOrder) (Date, bool) {
+	yi, mi, di := order.indexes()
+	year, month, day := fields[yi], fields[mi], fields[di]
+	if len(month) > 2 || len(day) > 2 || (len(year) != 4 && len(year) > 2) {
+		return Date{}, false
+	}
+
+	y := atoi(year)
+	if len(year) <= 2 {
+		y = p.expandYear(y)
+	}
+
+	d, err := New(y, time.Month(atoi(month)), atoi(day))
+	return d, err == nil
+}
+
+func (p AmbiguousParser) expandYear(yy int) int {
+	pivot := p.PivotYear
+	if pivot == 0 {
+		pivot = 1969
+	}
+
+	century := pivot - pivot%100
+	if pivot%100 < 0 {
+		century -= 100
+	}
+
+	y := century + yy
+	if y < pivot {
+		y += 100
+	}
+
+	return y
+}
+
+// splitFields splits value into three numeric fields. Eight digits without
+// separators are split according to order: 4-2-2 for YMD, 2-2-4 otherwise.
+func splitFields(value string, order FieldOrder) ([3]string, bool) {
+	if len(value) == 8 && allDigits(value) {
+		if order == YMD {
+			return [3]string{value[:4], value[4:6], value[6:]}, true
+		}
+		return [3]string{value[:2], value[2:4], value[4:]}, true
+	}
+
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.' || r == ' '
+	})
+	if len(fields) != 3 {
+		return [3]string{}, false
+	}
+
+	for _, f := range fields {
+		if !allDigits(f) {
+			return [3]string{}, false
+		}
+	}
+
+	return [3]string{fields[0], fields[1], fields[2]}, true
+}
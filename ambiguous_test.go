@@ -0,0 +1,120 @@
+package date_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestAmbiguousParser_Candidates(t *testing.T) {
+	cases := []struct {
+		name   string
+		parser AmbiguousParser
+		value  string
+		want   []Date
+	}{
+		{"all orders", AmbiguousParser{}, "03/04/2026", []Date{{2026, 4, 3}, {2026, 3, 4}}},
+		{"DMY preferred", AmbiguousParser{Orders: []FieldOrder{DMY, MDY}}, "03/04/2026", []Date{{2026, 4, 3}, {2026, 3, 4}}},
+		{"MDY preferred", AmbiguousParser{Orders: []FieldOrder{MDY, DMY}}, "03/04/2026", []Date{{2026, 3, 4}, {2026, 4, 3}}},
+		{"only DMY valid", AmbiguousParser{}, "13/04/2026", []Date{{2026, 4, 13}}},
+		{"only MDY valid", AmbiguousParser{}, "04/13/2026", []Date{{2026, 4, 13}}},
+		{"same in both orders", AmbiguousParser{}, "05.05.2026", []Date{{2026, 5, 5}}},
+		{"ISO", AmbiguousParser{}, "2026-04-03", []Date{{2026, 4, 3}}},
+		{"unpadded", AmbiguousParser{Orders: []FieldOrder{DMY}}, "3.4.2026", []Date{{2026, 4, 3}}},
+		{"two-digit years", AmbiguousParser{}, "03/04/05", []Date{{2003, 4, 5}, {2005, 4, 3}, {2005, 3, 4}}},
+		{"default pivot", AmbiguousParser{Orders: []FieldOrder{DMY}}, "01/01/69", []Date{{1969, 1, 1}}},
+		{"default pivot upper", AmbiguousParser{Orders: []FieldOrder{DMY}}, "01/01/68", []Date{{2068, 1, 1}}},
+		{"custom pivot", AmbiguousParser{Orders: []FieldOrder{DMY}, PivotYear: 1950}, "01/01/49", []Date{{2049, 1, 1}}},
+		{"custom pivot lower", AmbiguousParser{Orders: []FieldOrder{DMY}, PivotYear: 1950}, "01/01/50", []Date{{1950, 1, 1}}},
+		{"compact", AmbiguousParser{}, "20260403", []Date{{2026, 4, 3}}},
+		{"compact DMY", AmbiguousParser{Orders: []FieldOrder{DMY, MDY}}, "03042026", []Date{{2026, 4, 3}, {2026, 3, 4}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.parser.Candidates(c.value)
+			if err != nil {
+				t.Fatalf("Candidates(%q): %v", c.value, err)
+			}
+
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Candidates(%q) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestAmbiguousParser_Parse(t *testing.T) {
+	p := AmbiguousParser{Orders: LocaleOrders("en-US")}
+
+	d, err := p.Parse("04/13/2026")
+	if err != nil {
+		t.Fatalf("Parse(\"04/13/2026\"): %v", err)
+	}
+	if !d.Equal(Date{2026, 4, 13}) {
+		t.Errorf("Parse(\"04/13/2026\") = %v; want 2026-04-13", d)
+	}
+
+	_, err = p.Parse("03/04/05")
+	var ae *AmbiguousError
+	if !errors.As(err, &ae) {
+		t.Fatalf("Parse(\"03/04/05\") error = %v; want *AmbiguousError", err)
+	}
+
+	want := []Date{{2005, 3, 4}, {2003, 4, 5}}
+	if !reflect.DeepEqual(ae.Candidates, want) {
+		t.Errorf("Candidates = %v; want %v", ae.Candidates, want)
+	}
+}
+
+func TestAmbiguousParser_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		parser AmbiguousParser
+		value  string
+	}{
+		{"no valid reading", AmbiguousParser{}, "13/13/2026"},
+		{"invalid for order", AmbiguousParser{Orders: []FieldOrder{MDY}}, "13/04/2026"},
+		{"two fields", AmbiguousParser{}, "03/2026"},
+		{"letters", AmbiguousParser{}, "03/Apr/2026"},
+		{"three-digit year", AmbiguousParser{}, "03/04/202"},
+		{"empty", AmbiguousParser{}, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d, err := c.parser.Parse(c.value)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("Parse(%q) = %v, %v; want *ParseError", c.value, d, err)
+			}
+		})
+	}
+}
+
+func TestLocaleOrders(t *testing.T) {
+	cases := []struct {
+		tag  string
+		want []FieldOrder
+	}{
+		{"en-US", []FieldOrder{MDY, YMD}},
+		{"en_us", []FieldOrder{MDY, YMD}},
+		{"en-GB", []FieldOrder{DMY, YMD}},
+		{"de", []FieldOrder{DMY, YMD}},
+		{"ja-JP", []FieldOrder{YMD}},
+		{"zh-Hant-TW", []FieldOrder{YMD}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.tag, func(t *testing.T) {
+			got := LocaleOrders(c.tag)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("LocaleOrders(%q) = %v; want %v", c.tag, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package date
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time, so that code asking for today's date can be
+// tested on any day.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock of time.Now.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// DefaultClock is the Clock of Today and of the functions built on it, such
+// as Humanize. Tests can set it to a FakeClock, and must restore it.
+var DefaultClock Clock = SystemClock{}
+
+// TodayFrom returns the current date in l according to c.
+func TodayFrom(c Clock, l *time.Location) Date {
+	return FromTime(c.Now().In(l))
+}
+
+// FakeClock is a Clock controlled by tests. It starts frozen: its time only
+// changes when set or advanced, unless Unfreeze lets it run with real time.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+	// since is when the clock was unfrozen, or the zero time while frozen.
+	since time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.current()
+}
+
+func (c *FakeClock) current() time.Time {
+	if c.since.IsZero() {
+		return c.now
+	}
+	return c.now.Add(time.Since(c.since))
+}
+
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+	if !c.since.IsZero() {
+		c.since = time.Now()
+	}
+}
+
+// SetDate sets the clock to midnight at the start of d in l.
+func (c *FakeClock) SetDate(d Date, l *time.Location) {
+	c.Set(d.StartOfDay(l))
+}
+
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// AdvanceDays moves the clock by calendar days, keeping the wall clock time
+// in the clock's location across daylight saving changes.
+func (c *FakeClock) AdvanceDays(days int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.AddDate(0, 0, days)
+}
+
+// Freeze stops the clock at its current time.
+func (c *FakeClock) Freeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now, c.since = c.current(), time.Time{}
+}
+
+// Unfreeze lets the clock run with real time from its current time.
+func (c *FakeClock) Unfreeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.since.IsZero() {
+		c.since = time.Now()
+	}
+}
@@ -0,0 +1,126 @@
+package date_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestFakeClock(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	c := NewFakeClock(time.Date(2026, 10, 31, 20, 0, 0, 0, time.UTC))
+
+	if got := TodayFrom(c, time.UTC); got != (Date{2026, 10, 31}) {
+		t.Errorf("TodayFrom(UTC) = %v; want 2026-10-31", got)
+	}
+	if got := TodayFrom(c, tokyo); got != (Date{2026, 11, 1}) {
+		t.Errorf("TodayFrom(JST) = %v; want 2026-11-01", got)
+	}
+
+	c.Advance(4 * time.Hour)
+	if got := TodayFrom(c, time.UTC); got != (Date{2026, 11, 1}) {
+		t.Errorf("after Advance(4h) TodayFrom() = %v; want 2026-11-01", got)
+	}
+
+	c.AdvanceDays(60)
+	if got := TodayFrom(c, time.UTC); got != (Date{2026, 12, 31}) {
+		t.Errorf("after AdvanceDays(60) TodayFrom() = %v; want 2026-12-31", got)
+	}
+
+	c.SetDate(Date{2027, 1, 1}, tokyo)
+	if got := TodayFrom(c, tokyo); got != (Date{2027, 1, 1}) {
+		t.Errorf("after SetDate() TodayFrom(JST) = %v; want 2027-01-01", got)
+	}
+	if got := TodayFrom(c, time.UTC); got != (Date{2026, 12, 31}) {
+		t.Errorf("after SetDate() TodayFrom(UTC) = %v; want 2026-12-31", got)
+	}
+
+	set := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	c.Set(set)
+	if got := c.Now(); !got.Equal(set) {
+		t.Errorf("Now() = %v; want %v", got, set)
+	}
+}
+
+func TestFakeClock_AdvanceDaysKeepsWallClock(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	c := NewFakeClock(time.Date(2026, 10, 24, 9, 0, 0, 0, berlin))
+	c.AdvanceDays(2)
+
+	if got, want := c.Now(), time.Date(2026, 10, 26, 9, 0, 0, 0, berlin); !got.Equal(want) {
+		t.Errorf("AdvanceDays(2) across the DST change = %v; want %v", got, want)
+	}
+}
+
+func TestFakeClock_Freeze(t *testing.T) {
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	if got := c.Now(); !got.Equal(start) {
+		t.Fatalf("a new FakeClock moved: Now() = %v; want %v", got, start)
+	}
+
+	c.Unfreeze()
+	deadline := time.Now().Add(time.Second)
+	for !c.Now().After(start) {
+		if time.Now().After(deadline) {
+			t.Fatal("an unfrozen FakeClock does not run")
+		}
+	}
+
+	c.Freeze()
+	frozen := c.Now()
+	if !frozen.After(start) {
+		t.Errorf("Freeze() reset the clock to %v", frozen)
+	}
+	if got := c.Now(); !got.Equal(frozen) {
+		t.Errorf("a frozen FakeClock moved from %v to %v", frozen, got)
+	}
+
+	c.Advance(time.Hour)
+	if got := c.Now(); !got.Equal(frozen.Add(time.Hour)) {
+		t.Errorf("Advance(1h) = %v; want %v", got, frozen.Add(time.Hour))
+	}
+}
+
+func TestFakeClock_Concurrent(t *testing.T) {
+	c := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.AdvanceDays(1)
+				_ = c.Now()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := TodayFrom(c, time.UTC), (Date{Year: 2026, Month: 1, Day: 1}).AddDays(800); got != want {
+		t.Errorf("TodayFrom() = %v; want %v", got, want)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDefaultClock(t *testing.T) {
+	defer func(c Clock) { DefaultClock = c }(DefaultClock)
+
+	DefaultClock = NewFakeClock(time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC))
+	if got := Today(time.UTC); got != (Date{2026, 12, 31}) {
+		t.Errorf("Today() = %v; want 2026-12-31", got)
+	}
+
+	if got := Humanize(Date{2027, 1, 1}, time.UTC); got != "tomorrow" {
+		t.Errorf("Humanize(2027-01-01) = %q; want \"tomorrow\"", got)
+	}
+}
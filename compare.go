@@ -0,0 +1,134 @@
+package date
+
+import "fmt"
+
+// Compare returns -1 if d is before o, 0 if they are the same day and +1 if d
+// is after o. Date.Compare suits slices.SortFunc and slices.BinarySearchFunc.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Compare(o Date) int {
+	switch {
+	case d.Year != o.Year:
+		return compareInts(d.Year, o.Year)
+	case d.Month != o.Month:
+		return compareInts(int(d.Month), int(o.Month))
+	}
+	return compareInts(d.Day, o.Day)
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Min returns the earliest of the dates.
+func Min(first Date, rest ...Date) Date {
+	for _, d := range rest {
+		if d.IsBefore(first) {
+			first = d
+		}
+	}
+
+	return first
+}
+
+// Max returns the latest of the dates.
+func Max(first Date, rest ...Date) Date {
+	for _, d := range rest {
+		if d.IsAfter(first) {
+			first = d
+		}
+	}
+
+	return first
+}
+
+// Clamp returns lo if d is before lo, hi if d is after hi, and d otherwise. hi
+// must not be before lo.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Clamp(lo, hi Date) Date {
+	switch {
+	case d.IsBefore(lo):
+		return lo
+	case d.IsAfter(hi):
+		return hi
+	}
+	return d
+}
+
+// Bounds says whether the ends of an interval are part of it.
+type Bounds int
+
+const (
+	// BoundsClosed includes both ends, [a, b].
+	BoundsClosed Bounds = iota
+	// BoundsOpen excludes both ends, (a, b).
+	BoundsOpen
+	// BoundsClosedOpen includes the start and excludes the end, [a, b), the
+	// usual form for consecutive periods.
+	BoundsClosedOpen
+	// BoundsOpenClosed excludes the start and includes the end, (a, b].
+	BoundsOpenClosed
+)
+
+// String returns the brackets of the bounds, such as "[)".
+func (b Bounds) String() string {
+	switch b {
+	case BoundsClosed:
+		return "[]"
+	case BoundsOpen:
+		return "()"
+	case BoundsClosedOpen:
+		return "[)"
+	case BoundsOpenClosed:
+		return "(]"
+	}
+
+	return fmt.Sprintf("Bounds(%d)", int(b))
+}
+
+// Between reports whether d lies between a and b, including or excluding each
+// end as bounds says. It is false for every d if b is before a.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Between(a, b Date, bounds Bounds) bool {
+	lo, hi := d.Compare(a), d.Compare(b)
+
+	switch bounds {
+	case BoundsOpen:
+		return lo > 0 && hi < 0
+	case BoundsClosedOpen:
+		return lo >= 0 && hi < 0
+	case BoundsOpenClosed:
+		return lo > 0 && hi <= 0
+	}
+	return lo >= 0 && hi <= 0
+}
+
+// NullOrder places null dates before or after every valid date.
+type NullOrder int
+
+const (
+	NullsFirst NullOrder = iota
+	NullsLast
+)
+
+// Compare is like Date.Compare for nullable dates, ordering nulls as o says;
+// two nulls are equal. NullsLast.Compare can be passed to slices.SortFunc.
+func (o NullOrder) Compare(a, b NullDate) int {
+	switch {
+	case a.Valid && b.Valid:
+		return a.Date.Compare(b.Date)
+	case a.Valid == b.Valid:
+		return 0
+	case a.Valid == (o == NullsFirst):
+		return 1
+	}
+	return -1
+}
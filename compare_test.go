@@ -0,0 +1,166 @@
+package date_test
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Compare(t *testing.T) {
+	cases := []struct {
+		a, b Date
+		want int
+	}{
+		{Date{2026, 10, 17}, Date{2026, 10, 17}, 0},
+		{Date{2026, 10, 17}, Date{2026, 10, 18}, -1},
+		{Date{2026, 11, 1}, Date{2026, 10, 31}, 1},
+		{Date{2025, 12, 31}, Date{2026, 1, 1}, -1},
+		{Infinity, Date{9999, 12, 31}, 1},
+		{NegInfinity, Date{-9999, 1, 1}, -1},
+		{NegInfinity, Infinity, -1},
+	}
+
+	for _, c := range cases {
+		if got := c.a.Compare(c.b); got != c.want {
+			t.Errorf("%v.Compare(%v) = %d; want %d", c.a, c.b, got, c.want)
+		}
+		if got := c.b.Compare(c.a); got != -c.want {
+			t.Errorf("%v.Compare(%v) = %d; want %d", c.b, c.a, got, -c.want)
+		}
+
+		if got := MustPack(c.a).Compare(MustPack(c.b)); got != c.want {
+			t.Errorf("Packed %v.Compare(%v) = %d; want %d", c.a, c.b, got, c.want)
+		}
+	}
+
+	dates := []Date{{2026, 10, 17}, Infinity, {2024, 2, 29}, NegInfinity, {2026, 1, 1}}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Compare(dates[j]) < 0 })
+
+	want := []Date{NegInfinity, {2024, 2, 29}, {2026, 1, 1}, {2026, 10, 17}, Infinity}
+	for i := range want {
+		if dates[i] != want[i] {
+			t.Fatalf("sorted = %v; want %v", dates, want)
+		}
+	}
+
+	i := sort.Search(len(dates), func(i int) bool { return dates[i].Compare(Date{2026, 1, 1}) >= 0 })
+	if i != 2 {
+		t.Errorf("search for 2026-01-01 = %d; want 2", i)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestMinMax(t *testing.T) {
+	a, b, c := Date{2026, 10, 17}, Date{2024, 2, 29}, Date{2026, 10, 18}
+
+	if got := Min(a); got != a {
+		t.Errorf("Min(%v) = %v", a, got)
+	}
+	if got := Min(a, b, c); got != b {
+		t.Errorf("Min() = %v; want %v", got, b)
+	}
+	if got := Max(a, b, c); got != c {
+		t.Errorf("Max() = %v; want %v", got, c)
+	}
+	if got := Max(a, Infinity, c); got != Infinity {
+		t.Errorf("Max() = %v; want infinity", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Clamp(t *testing.T) {
+	lo, hi := Date{2026, 1, 1}, Date{2026, 12, 31}
+
+	cases := []struct {
+		d, want Date
+	}{
+		{Date{2026, 10, 17}, Date{2026, 10, 17}},
+		{Date{2025, 12, 31}, lo},
+		{Date{2027, 1, 1}, hi},
+		{lo, lo},
+		{hi, hi},
+		{NegInfinity, lo},
+		{Infinity, hi},
+	}
+
+	for _, c := range cases {
+		if got := c.d.Clamp(lo, hi); got != c.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v; want %v", c.d, lo, hi, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Between(t *testing.T) {
+	a, b := Date{2026, 10, 1}, Date{2026, 10, 31}
+
+	cases := []struct {
+		d      Date
+		bounds Bounds
+		want   bool
+	}{
+		{Date{2026, 10, 17}, BoundsClosed, true},
+		{Date{2026, 10, 17}, BoundsOpen, true},
+		{a, BoundsClosed, true},
+		{b, BoundsClosed, true},
+		{a, BoundsOpen, false},
+		{b, BoundsOpen, false},
+		{a, BoundsClosedOpen, true},
+		{b, BoundsClosedOpen, false},
+		{a, BoundsOpenClosed, false},
+		{b, BoundsOpenClosed, true},
+		{Date{2026, 9, 30}, BoundsClosed, false},
+		{Date{2026, 11, 1}, BoundsClosed, false},
+	}
+
+	for _, c := range cases {
+		if got := c.d.Between(a, b, c.bounds); got != c.want {
+			t.Errorf("%v.Between(%v, %v, %v) = %t; want %t", c.d, a, b, c.bounds, got, c.want)
+		}
+	}
+
+	if (Date{2026, 10, 17}).Between(b, a, BoundsClosed) {
+		t.Error("Between() with b before a should be false")
+	}
+
+	if got := Bounds(9).String(); got != "Bounds(9)" {
+		t.Errorf("Bounds(9).String() = %q", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNullOrder_Compare(t *testing.T) {
+	d1, d2 := NullDateFrom(Date{2026, 10, 17}), NullDateFrom(Date{2026, 10, 18})
+	null := NullDate{}
+
+	cases := []struct {
+		order NullOrder
+		a, b  NullDate
+		want  int
+	}{
+		{NullsFirst, d1, d2, -1},
+		{NullsLast, d2, d1, 1},
+		{NullsFirst, d1, d1, 0},
+		{NullsFirst, null, null, 0},
+		{NullsLast, null, null, 0},
+		{NullsFirst, null, d1, -1},
+		{NullsFirst, d1, null, 1},
+		{NullsLast, null, d1, 1},
+		{NullsLast, d1, null, -1},
+		{NullsLast, NullDateFrom(Infinity), null, -1},
+	}
+
+	for _, c := range cases {
+		if got := c.order.Compare(c.a, c.b); got != c.want {
+			t.Errorf("%d.Compare(%v, %v) = %d; want %d", c.order, c.a, c.b, got, c.want)
+		}
+	}
+
+	dates := []NullDate{d2, null, d1}
+	sort.Slice(dates, func(i, j int) bool { return NullsLast.Compare(dates[i], dates[j]) < 0 })
+	if dates[0] != d1 || dates[1] != d2 || dates[2] != null {
+		t.Errorf("sorted with NullsLast = %v", dates)
+	}
+}
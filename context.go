@@ -0,0 +1,43 @@
+package date
+
+import (
+	"context"
+	"time"
+)
+
+type asOfKey struct{}
+
+type clockKey struct{}
+
+// WithAsOf returns a copy of ctx in which TodayFromContext returns d, for
+// work done as of a past or future date.
+func WithAsOf(ctx context.Context, d Date) context.Context {
+	return context.WithValue(ctx, asOfKey{}, d)
+}
+
+// AsOf returns the date set by WithAsOf, if any.
+func AsOf(ctx context.Context) (Date, bool) {
+	d, ok := ctx.Value(asOfKey{}).(Date)
+	return d, ok
+}
+
+// WithClock returns a copy of ctx in which TodayFromContext reads c when no
+// as-of date is set.
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, c)
+}
+
+// TodayFromContext returns the as-of date of ctx. Without one, it returns the
+// current date in l according to the Clock set by WithClock, or DefaultClock.
+// An as-of date is the same in every location.
+func TodayFromContext(ctx context.Context, l *time.Location) Date {
+	if d, ok := AsOf(ctx); ok {
+		return d
+	}
+
+	if c, ok := ctx.Value(clockKey{}).(Clock); ok {
+		return TodayFrom(c, l)
+	}
+
+	return Today(l)
+}
@@ -0,0 +1,53 @@
+package date_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestTodayFromContext(t *testing.T) {
+	defer func(c Clock) { DefaultClock = c }(DefaultClock)
+	DefaultClock = NewFakeClock(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	ctx := context.Background()
+	if got := TodayFromContext(ctx, time.UTC); got != (Date{2026, 10, 18}) {
+		t.Errorf("TodayFromContext(no as-of date) = %v; want 2026-10-18", got)
+	}
+
+	if _, ok := AsOf(ctx); ok {
+		t.Error("AsOf() of a background context reported a date")
+	}
+
+	clock := NewFakeClock(time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC))
+	withClock := WithClock(ctx, clock)
+	if got := TodayFromContext(withClock, time.UTC); got != (Date{2026, 12, 31}) {
+		t.Errorf("TodayFromContext(WithClock) = %v; want 2026-12-31", got)
+	}
+
+	clock.Advance(2 * time.Hour)
+	if got := TodayFromContext(withClock, time.UTC); got != (Date{2027, 1, 1}) {
+		t.Errorf("TodayFromContext(WithClock) after Advance = %v; want 2027-01-01", got)
+	}
+
+	asOf := Date{2026, 3, 31}
+	backdated, cancel := context.WithCancel(WithAsOf(withClock, asOf))
+	defer cancel()
+
+	for _, l := range []*time.Location{time.UTC, time.FixedZone("", 14*60*60)} {
+		if got := TodayFromContext(backdated, l); got != asOf {
+			t.Errorf("TodayFromContext(WithAsOf, %v) = %v; want %v", l, got, asOf)
+		}
+	}
+
+	if got, ok := AsOf(backdated); !ok || got != asOf {
+		t.Errorf("AsOf() = %v, %t; want %v, true", got, ok, asOf)
+	}
+
+	if got := TodayFromContext(WithAsOf(backdated, Date{2025, 12, 31}), time.UTC); got != (Date{2025, 12, 31}) {
+		t.Errorf("TodayFromContext(nested WithAsOf) = %v; want 2025-12-31", got)
+	}
+}
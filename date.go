@@ -2,8 +2,11 @@ package date
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -13,8 +16,18 @@ type Date struct {
 	Day   int
 }
 
+// Infinity and NegInfinity stand for PostgreSQL's "infinity" and "-infinity"
+// dates, as used for open-ended periods. They sort after and before every
+// other date, are written as "infinity" and "-infinity", and are not moved by
+// date arithmetic.
+var (
+	Infinity    = Date{math.MaxInt, time.December, 31}
+	NegInfinity = Date{math.MinInt, time.January, 1}
+)
+
+// Today returns the current date in l according to DefaultClock.
 func Today(l *time.Location) Date {
-	return FromTime(time.Now().In(l))
+	return TodayFrom(DefaultClock, l)
 }
 
 func FromISO8601(date string) (Date, error) {
@@ -85,6 +98,42 @@ func (d Date) Time(l *time.Location) time.Time {
 	return startOfDay(d.Year, d.Month, d.Day, l)
 }
 
+// IsValid reports whether d is a real calendar date, as returned by New, or
+// one of Infinity and NegInfinity. A Date built from a literal, such as
+// Date{2023, 2, 30}, need not be.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) IsValid() bool {
+	if d.IsInfinite() {
+		return true
+	}
+
+	return d.Month >= time.January && d.Month <= time.December && d.Day >= 1 && d.Day <= daysInMonth(d.Year, d.Month)
+}
+
+// Normalize returns the valid date d stands for, carrying months and days
+// that are out of range into the following or previous months the way
+// time.Date does: Date{2023, 2, 30} is 2023-03-02 and Date{2023, 13, 1} is
+// 2024-01-01.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Normalize() Date {
+	if d.IsValid() {
+		return d
+	}
+
+	months := int(d.Month) - 1
+	year := d.Year + floorDiv(months, 12)
+	month := time.Month(months - floorDiv(months, 12)*12 + 1)
+
+	return fromDaysSinceEpoch(daysSinceEpoch(year, month, 1) + d.Day - 1)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) IsInfinite() bool {
+	return d == Infinity || d == NegInfinity
+}
+
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) AddDays(days int) Date {
 	return d.add(0, 0, days)
@@ -107,6 +156,9 @@ func StartOfMonth(t time.Time) time.Time {
 
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) FirstOfMonth() Date {
+	if d.IsInfinite() {
+		return d
+	}
 	return Date{d.Year, d.Month, 1}
 }
 
@@ -117,9 +169,50 @@ func EndOfMonth(t time.Time) time.Time {
 
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) LastOfMonth() Date {
+	if d.IsInfinite() {
+		return d
+	}
 	return Date{d.Year, d.Month, daysInMonth(d.Year, d.Month)}
 }
 
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Quarter() int {
+	return (int(d.Month)-1)/3 + 1
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) FirstOfQuarter() Date {
+	if d.IsInfinite() {
+		return d
+	}
+	return Date{d.Year, time.Month((d.Quarter()-1)*3 + 1), 1}
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) LastOfQuarter() Date {
+	if d.IsInfinite() {
+		return d
+	}
+	m := time.Month(d.Quarter() * 3)
+	return Date{d.Year, m, daysInMonth(d.Year, m)}
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) FirstOfYear() Date {
+	if d.IsInfinite() {
+		return d
+	}
+	return Date{d.Year, time.January, 1}
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) LastOfYear() Date {
+	if d.IsInfinite() {
+		return d
+	}
+	return Date{d.Year, time.December, 31}
+}
+
 func FirstOfWeek(t time.Time) time.Time {
 	wd := t.Weekday()
 	if wd == time.Monday {
@@ -132,6 +225,9 @@ func FirstOfWeek(t time.Time) time.Time {
 
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) FirstOfWeek() Date {
+	if d.IsInfinite() {
+		return d
+	}
 	return FromTime(FirstOfWeek(d.Time(time.UTC)))
 }
 
@@ -145,59 +241,267 @@ func LastOfWeek(t time.Time) time.Time {
 
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) LastOfWeek() Date {
+	if d.IsInfinite() {
+		return d
+	}
 	return FromTime(LastOfWeek(d.Time(time.UTC)))
 }
 
+// Weekday, YearDay and ISOWeek have no answer for Infinity and NegInfinity,
+// and return Sunday and zeros for them.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Weekday() time.Weekday {
+	if d.IsInfinite() {
+		return time.Sunday
+	}
+	return d.Time(time.UTC).Weekday()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) YearDay() int {
+	if d.IsInfinite() {
+		return 0
+	}
+	return d.Time(time.UTC).YearDay()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) ISOWeek() (year, week int) {
+	if d.IsInfinite() {
+		return 0, 0
+	}
+	return d.Time(time.UTC).ISOWeek()
+}
+
+// IsWeekend is false for Infinity and NegInfinity.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) IsWeekend() bool {
+	if d.IsInfinite() {
+		return false
+	}
+
+	wd := d.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// AddBusinessDays moves n days forward (or backward if n is negative),
+// counting only Monday to Friday: adding 1 to a Friday, Saturday or Sunday
+// gives the following Monday.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) AddBusinessDays(n int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		d = d.AddDays(step)
+		if !d.IsWeekend() {
+			n--
+		}
+	}
+
+	return d
+}
+
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) Equal(o Date) bool {
 	return d.Year == o.Year && d.Month == o.Month && d.Day == o.Day
 }
 
+// MarshalJSON writes the date as a string, like MarshalText.
+//
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) MarshalJSON() ([]byte, error) {
+	if err := d.checkMarshal("MarshalJSON"); err != nil {
+		return nil, err
+	}
 	return json.Marshal(d.String())
 }
 
 //goland:noinspection GoMixedReceiverTypes
 func (d *Date) UnmarshalJSON(data []byte) error {
-	var str string
-	err := json.Unmarshal(data, &str)
-	if err != nil {
-		return err
+	return JSONParser.Unmarshaler(d).UnmarshalJSON(data)
+}
+
+// MarshalText writes the date in ISO 8601 form. The zero Date is written as
+// "0000-00-00", which UnmarshalText reads back; other dates that are not valid
+// are rejected.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) MarshalText() ([]byte, error) {
+	if err := d.checkMarshal("MarshalText"); err != nil {
+		return nil, err
+	}
+	return []byte(d.String()), nil
+}
+
+// checkMarshal rejects the dates that the encodings cannot read back: every
+// date that is not valid, except the zero Date.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) checkMarshal(method string) error {
+	if d != (Date{}) && !d.IsValid() {
+		return fmt.Errorf("Date.%s: date %v is not valid", method, d)
+	}
+	return nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *Date) UnmarshalText(data []byte) error {
+	return JSONParser.Unmarshaler(d).UnmarshalText(data)
+}
+
+const binaryVersion byte = 1
+
+// MarshalBinary encodes the date as a version byte, the year as a varint and
+// one byte each for the month and day; a date in 2026 takes five bytes. Like
+// MarshalText, it accepts the zero Date and rejects other dates that are not
+// valid.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) MarshalBinary() ([]byte, error) {
+	if err := d.checkMarshal("MarshalBinary"); err != nil {
+		return nil, err
+	}
+	return d.appendBinary(make([]byte, 0, 8)), nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) appendBinary(b []byte) []byte {
+	b = append(b, binaryVersion)
+	b = binary.AppendVarint(b, int64(d.Year))
+	return append(b, byte(d.Month), byte(d.Day))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("Date.UnmarshalBinary: no data")
 	}
 
-	date, err := FromISO8601(str)
+	if data[0] != binaryVersion {
+		return fmt.Errorf("Date.UnmarshalBinary: unsupported version %d", data[0])
+	}
+
+	year, n := binary.Varint(data[1:])
+	if n <= 0 || len(data) != 1+n+2 {
+		return errors.New("Date.UnmarshalBinary: invalid length")
+	}
+
+	if year == 0 && data[1+n] == 0 && data[2+n] == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := New(int(year), time.Month(data[1+n]), int(data[2+n]))
 	if err != nil {
-		return err
+		return fmt.Errorf("Date.UnmarshalBinary: %w", err)
 	}
 
-	d.Year, d.Month, d.Day = date.Year, date.Month, date.Day
+	*d = date
 	return nil
 }
 
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *Date) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+var longLayout = MustLayout("Monday, January 2, 2006")
+
+// Format implements fmt.Formatter. %v and %s print the ISO 8601 form
+// ("2026-10-17"), %+v and %+s the long form ("Saturday, October 17, 2026"),
+// %d the short form of ShortString ("261017"), %q the quoted ISO 8601 form
+// and %#v Go syntax. A width pads the result, on the right with the '-' flag.
+// Dates that are not valid or are infinite print their String form for %+v.
+// FormatLayout formats with a layout instead.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Format(s fmt.State, verb rune) {
+	var str string
+	switch {
+	case verb == 'v' && s.Flag('#'):
+		str = fmt.Sprintf("date.Date{Year:%d, Month:%d, Day:%d}", d.Year, int(d.Month), d.Day)
+	case (verb == 'v' || verb == 's') && s.Flag('+') && d.IsValid() && !d.IsInfinite():
+		str = longLayout.Format(d)
+	case verb == 'v' || verb == 's':
+		str = d.String()
+	case verb == 'q':
+		str = `"` + d.String() + `"`
+	case verb == 'd':
+		str = d.ShortString()
+	default:
+		str = fmt.Sprintf("%%!%c(date.Date=%s)", verb, d.String())
+	}
+
+	writePadded(s, str)
+}
+
+func writePadded(s fmt.State, str string) {
+	width, ok := s.Width()
+	if !ok || width <= len(str) {
+		_, _ = fmt.Fprint(s, str)
+		return
+	}
+
+	padding := make([]byte, width-len(str))
+	for i := range padding {
+		padding[i] = ' '
+	}
+
+	if s.Flag('-') {
+		_, _ = fmt.Fprint(s, str, string(padding))
+	} else {
+		_, _ = fmt.Fprint(s, string(padding), str)
+	}
+}
+
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) String() string {
+	switch d {
+	case Infinity:
+		return "infinity"
+	case NegInfinity:
+		return "-infinity"
+	}
+
 	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
 }
 
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) ShortString() string {
+	if d.IsInfinite() {
+		return d.String()
+	}
+
 	return fmt.Sprintf("%02d%02d%02d", d.Year%100, d.Month, d.Day)
 }
 
+// Value returns d in DefaultValueFormat.
+//
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) Value() (driver.Value, error) {
-	return d.String(), nil
+	return DefaultValueFormat.value(d)
 }
 
+// Scan accepts the driver values allowed by DefaultScanOptions.
+//
 //goland:noinspection GoMixedReceiverTypes
 func (d *Date) Scan(value any) error {
-	if t, ok := value.(time.Time); ok {
-		d.Year, d.Month, d.Day = t.Year(), t.Month(), t.Day()
-		return nil
-	}
-
-	return fmt.Errorf("cannot scan type %T into Date", value)
+	return DefaultScanOptions.Scanner(d).Scan(value)
 }
 
 func StartOfDay(t time.Time) time.Time {
@@ -220,9 +524,17 @@ func (d Date) EndOfDay(l *time.Location) time.Time {
 	return endOfDay(d.Year, d.Month, d.Day, l)
 }
 
+// DiffInDays returns the number of days between d1 and d2, in either order.
+// It is math.MaxInt between an infinite date and any other date.
 func DiffInDays(d1 Date, d2 Date) int {
-	diff := d1.Time(time.UTC).Sub(d2.Time(time.UTC))
-	return int(diff.Abs() / (24 * time.Hour))
+	if d1.IsInfinite() || d2.IsInfinite() {
+		if d1 == d2 {
+			return 0
+		}
+		return math.MaxInt
+	}
+
+	return abs(d1.UnixDays() - d2.UnixDays())
 }
 
 //goland:noinspection GoMixedReceiverTypes
@@ -235,8 +547,30 @@ func (d Date) EndOfMonth() Date {
 	return d.add(0, 1, -d.Day)
 }
 
+// addMonthsClamped adds months, moving to the last day of the resulting month
+// instead of overflowing into the next one: January 31 plus one month is the
+// last day of February.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) addMonthsClamped(months int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	first := Date{d.Year, d.Month, 1}.AddMonths(months)
+	if last := daysInMonth(first.Year, first.Month); d.Day > last {
+		return Date{first.Year, first.Month, last}
+	}
+
+	return Date{first.Year, first.Month, d.Day}
+}
+
 //goland:noinspection GoMixedReceiverTypes
 func (d Date) add(years, months, days int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	return FromTime(d.Time(time.UTC).AddDate(years, months, days))
 }
 
@@ -271,6 +605,14 @@ func daysInMonth(year int, month time.Month) int {
 	return monthDays[int(month)]
 }
 
+func daysInYear(year int) int {
+	if isLeapYear(year) {
+		return 366
+	}
+
+	return 365
+}
+
 func isLeapYear(year int) bool {
 	if year%400 == 0 {
 		return true
@@ -282,3 +624,61 @@ func isLeapYear(year int) bool {
 
 	return year%4 == 0
 }
+
+// daysSinceEpoch returns the number of days from 1970-01-01 to d in the
+// proleptic Gregorian calendar.
+func daysSinceEpoch(year int, month time.Month, day int) int {
+	y := year
+	if month <= 2 {
+		y--
+	}
+
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	mp := (int(month) + 9) % 12
+	doy := (153*mp+2)/5 + day - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+
+	return era*146097 + doe - 719468
+}
+
+// fromDaysSinceEpoch is the inverse of daysSinceEpoch.
+func fromDaysSinceEpoch(days int) Date {
+	z := days + 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day := doy - (153*mp+2)/5 + 1
+	month := (mp+2)%12 + 1
+
+	year := yoe + era*400
+	if month <= 2 {
+		year++
+	}
+
+	return Date{year, time.Month(month), day}
+}
+
+// parseInfinity recognizes the PostgreSQL spellings of Infinity and
+// NegInfinity.
+func parseInfinity(s string) (Date, bool) {
+	switch s {
+	case "infinity", "+infinity":
+		return Infinity, true
+	case "-infinity":
+		return NegInfinity, true
+	}
+
+	return Date{}, false
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestDate_add(t *testing.T) {
@@ -53,3 +54,42 @@ func TestIsLeapYear(t *testing.T) {
 		})
 	}
 }
+
+func TestDate_addMonthsClamped(t *testing.T) {
+	cases := []struct {
+		date   Date
+		months int
+		want   string
+	}{
+		{Date{2026, 1, 31}, 1, "2026-02-28"},
+		{Date{2024, 1, 31}, 1, "2024-02-29"},
+		{Date{2026, 3, 31}, -1, "2026-02-28"},
+		{Date{2026, 5, 31}, 1, "2026-06-30"},
+		{Date{2026, 1, 15}, 1, "2026-02-15"},
+		{Date{2026, 12, 31}, 2, "2027-02-28"},
+		{Date{2024, 2, 29}, 12, "2025-02-28"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s%+d", c.date.String(), c.months), func(t *testing.T) {
+			got := c.date.addMonthsClamped(c.months)
+			if got.String() != c.want {
+				t.Errorf("%v.addMonthsClamped(%d) = %v; want %v", c.date, c.months, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDaysSinceEpoch(t *testing.T) {
+	for d := time.Date(-1000, 1, 1, 0, 0, 0, 0, time.UTC); d.Year() < 3000; d = d.AddDate(0, 0, 1) {
+		want := int(d.Unix() / 86400)
+		date := FromTime(d)
+		if got := daysSinceEpoch(date.Year, date.Month, date.Day); got != want {
+			t.Fatalf("daysSinceEpoch(%v) = %d; want %d", date, got, want)
+		}
+
+		if got := fromDaysSinceEpoch(want); got != date {
+			t.Fatalf("fromDaysSinceEpoch(%d) = %v; want %v", want, got, date)
+		}
+	}
+}
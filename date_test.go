@@ -3,7 +3,10 @@ package date_test
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"math"
 	"runtime"
 	"strconv"
 	"testing"
@@ -206,6 +209,8 @@ func TestDate_ShortString(t *testing.T) {
 		{Date{2001, 12, 24}, "011224"},
 		{Date{2004, 2, 29}, "040229"},
 		{Date{4892, 2, 29}, "920229"},
+		{Infinity, "infinity"},
+		{NegInfinity, "-infinity"},
 	}
 
 	for _, c := range cases {
@@ -673,6 +678,31 @@ func TestDiffInDays(t *testing.T) {
 			d2:   Date{2006, 2, 28},
 			want: 365,
 		},
+		{
+			d1:   Date{1500, 1, 1},
+			d2:   Date{2000, 1, 1},
+			want: 182621,
+		},
+		{
+			d1:   Infinity,
+			d2:   Date{2023, 9, 1},
+			want: math.MaxInt,
+		},
+		{
+			d1:   Date{2023, 9, 1},
+			d2:   NegInfinity,
+			want: math.MaxInt,
+		},
+		{
+			d1:   NegInfinity,
+			d2:   Infinity,
+			want: math.MaxInt,
+		},
+		{
+			d1:   Infinity,
+			d2:   Infinity,
+			want: 0,
+		},
 	}
 
 	for _, c := range cases {
@@ -812,3 +842,513 @@ func BenchmarkDate_AddYears(b *testing.B) {
 		})
 	}
 }
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Quarter(t *testing.T) {
+	cases := []struct {
+		date        Date
+		quarter     int
+		first, last Date
+	}{
+		{Date{2026, 1, 1}, 1, Date{2026, 1, 1}, Date{2026, 3, 31}},
+		{Date{2024, 2, 29}, 1, Date{2024, 1, 1}, Date{2024, 3, 31}},
+		{Date{2026, 6, 30}, 2, Date{2026, 4, 1}, Date{2026, 6, 30}},
+		{Date{2026, 8, 15}, 3, Date{2026, 7, 1}, Date{2026, 9, 30}},
+		{Date{2026, 10, 17}, 4, Date{2026, 10, 1}, Date{2026, 12, 31}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.date.String(), func(t *testing.T) {
+			if got := c.date.Quarter(); got != c.quarter {
+				t.Errorf("%v.Quarter() = %d; want %d", c.date, got, c.quarter)
+			}
+			if got := c.date.FirstOfQuarter(); !got.Equal(c.first) {
+				t.Errorf("%v.FirstOfQuarter() = %v; want %v", c.date, got, c.first)
+			}
+			if got := c.date.LastOfQuarter(); !got.Equal(c.last) {
+				t.Errorf("%v.LastOfQuarter() = %v; want %v", c.date, got, c.last)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_FirstOfYear(t *testing.T) {
+	date := Date{2026, 10, 17}
+	if got := date.FirstOfYear(); !got.Equal(Date{2026, 1, 1}) {
+		t.Errorf("%v.FirstOfYear() = %v; want 2026-01-01", date, got)
+	}
+	if got := date.LastOfYear(); !got.Equal(Date{2026, 12, 31}) {
+		t.Errorf("%v.LastOfYear() = %v; want 2026-12-31", date, got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_AddBusinessDays(t *testing.T) {
+	cases := []struct {
+		date Date
+		days int
+		want Date
+	}{
+		{Date{2026, 10, 14}, 0, Date{2026, 10, 14}},
+		{Date{2026, 10, 14}, 1, Date{2026, 10, 15}},
+		{Date{2026, 10, 16}, 1, Date{2026, 10, 19}},
+		{Date{2026, 10, 17}, 1, Date{2026, 10, 19}},
+		{Date{2026, 10, 18}, 1, Date{2026, 10, 19}},
+		{Date{2026, 10, 14}, 5, Date{2026, 10, 21}},
+		{Date{2026, 10, 14}, 10, Date{2026, 10, 28}},
+		{Date{2026, 10, 19}, -1, Date{2026, 10, 16}},
+		{Date{2026, 10, 17}, -1, Date{2026, 10, 16}},
+		{Date{2026, 10, 21}, -5, Date{2026, 10, 14}},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v%+d", c.date, c.days), func(t *testing.T) {
+			got := c.date.AddBusinessDays(c.days)
+			if !got.Equal(c.want) {
+				t.Errorf("%v.AddBusinessDays(%d) = %v; want %v", c.date, c.days, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_MarshalText(t *testing.T) {
+	date := Date{2026, 10, 17}
+	got, err := date.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(): %v", err)
+	}
+
+	if string(got) != "2026-10-17" {
+		t.Errorf("MarshalText() = %s, <nil>; want 2026-10-17, <nil>", got)
+	}
+
+	var parsed Date
+	if err := parsed.UnmarshalText(got); err != nil {
+		t.Fatalf("UnmarshalText(%s): %v", got, err)
+	}
+
+	if !parsed.Equal(date) {
+		t.Errorf("UnmarshalText(%s) = %v; want %v", got, parsed, date)
+	}
+
+	if err := parsed.UnmarshalText([]byte("2026-02-30")); err == nil {
+		t.Error("UnmarshalText(2026-02-30) = <nil>; want error")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_JSONMapKey(t *testing.T) {
+	m := map[Date]int{{2026, 10, 17}: 1, {2026, 1, 5}: 2}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+
+	want := `{"2026-01-05":2,"2026-10-17":1}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s; want %s", data, want)
+	}
+
+	var got map[Date]int
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+
+	if len(got) != 2 || got[Date{2026, 10, 17}] != 1 || got[Date{2026, 1, 5}] != 2 {
+		t.Errorf("json.Unmarshal() = %v; want %v", got, m)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_MarshalBinary(t *testing.T) {
+	cases := []Date{
+		{2026, 10, 17},
+		{1, 1, 1},
+		{-44, 3, 15},
+		{0, 2, 29},
+		{9999, 12, 31},
+		{1 << 40, 12, 31},
+	}
+
+	for _, c := range cases {
+		t.Run(c.String(), func(t *testing.T) {
+			data, err := c.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary(): %v", err)
+			}
+
+			var got Date
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary(%v): %v", data, err)
+			}
+
+			if !got.Equal(c) {
+				t.Errorf("UnmarshalBinary(MarshalBinary(%v)) = %v", c, got)
+			}
+		})
+	}
+
+	data, _ := Date{2026, 10, 17}.MarshalBinary()
+	if len(data) != 5 {
+		t.Errorf("len(MarshalBinary(2026-10-17)) = %d; want 5", len(data))
+	}
+}
+
+func TestDate_UnmarshalBinary_Errors(t *testing.T) {
+	cases := [][]byte{
+		nil,
+		{2, 0xcc, 0x1f, 10, 17},
+		{1, 0xcc, 0x1f, 10},
+		{1, 0xcc, 0x1f, 10, 17, 0},
+		{1, 0xcc, 0x1f, 2, 30},
+		{1, 0xcc, 0x1f, 13, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v", c), func(t *testing.T) {
+			var d Date
+			if err := d.UnmarshalBinary(c); err == nil {
+				t.Errorf("UnmarshalBinary(%v) = <nil>; want error", c)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Gob(t *testing.T) {
+	type record struct {
+		Date     Date
+		NullDate NullDate
+		Missing  NullDate
+	}
+
+	in := record{Date{2026, 10, 17}, NullDateFrom(Date{2024, 2, 29}), NullDate{}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode(): %v", err)
+	}
+
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode(): %v", err)
+	}
+
+	if out != in {
+		t.Errorf("Decode(Encode(%v)) = %v", in, out)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_ZeroRoundTrip(t *testing.T) {
+	dates := []Date{{}, {2026, 1, 1}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(dates); err != nil {
+		t.Fatalf("gob Encode(): %v", err)
+	}
+
+	var fromGob []Date
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil || len(fromGob) != 2 || fromGob[0] != dates[0] || fromGob[1] != dates[1] {
+		t.Errorf("gob Decode() = %v, %v; want %v", fromGob, err, dates)
+	}
+
+	data, err := json.Marshal(map[Date]int{{}: 1})
+	if err != nil || string(data) != `{"0000-00-00":1}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+
+	var keys map[Date]int
+	if err := json.Unmarshal(data, &keys); err != nil || keys[Date{}] != 1 {
+		t.Errorf("json.Unmarshal(%s) = %v, %v", data, keys, err)
+	}
+
+	fromJSON := Infinity
+	if err := json.Unmarshal([]byte(`"0000-00-00"`), &fromJSON); err != nil || fromJSON != (Date{}) {
+		t.Errorf("json.Unmarshal(0000-00-00) = %v, %v; want Date{}", fromJSON, err)
+	}
+
+	bin, err := Date{}.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %v", err)
+	}
+
+	fromBinary := Infinity
+	if err := fromBinary.UnmarshalBinary(bin); err != nil || fromBinary != (Date{}) {
+		t.Errorf("UnmarshalBinary(%x) = %v, %v; want Date{}", bin, fromBinary, err)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Marshal_NotValid(t *testing.T) {
+	for _, d := range []Date{{2026, 2, 30}, {2026, 13, 1}, {2026, 0, 1}} {
+		if data, err := d.MarshalText(); err == nil {
+			t.Errorf("%#v.MarshalText() = %s, <nil>; want error", d, data)
+		}
+		if data, err := json.Marshal(d); err == nil {
+			t.Errorf("json.Marshal(%#v) = %s, <nil>; want error", d, data)
+		}
+		if data, err := d.MarshalBinary(); err == nil {
+			t.Errorf("%#v.MarshalBinary() = %x, <nil>; want error", d, data)
+		}
+		if data, err := NullDateFrom(d).MarshalBinary(); err == nil {
+			t.Errorf("NullDateFrom(%#v).MarshalBinary() = %x, <nil>; want error", d, data)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Format(t *testing.T) {
+	date := Date{2026, 10, 7}
+
+	cases := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "2026-10-07"},
+		{"%s", "2026-10-07"},
+		{"%+v", "Wednesday, October 7, 2026"},
+		{"%+s", "Wednesday, October 7, 2026"},
+		{"%d", "261007"},
+		{"%q", `"2026-10-07"`},
+		{"%#v", "date.Date{Year:2026, Month:10, Day:7}"},
+		{"%12v|", "  2026-10-07|"},
+		{"%-12v|", "2026-10-07  |"},
+		{"%x", "%!x(date.Date=2026-10-07)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.format, func(t *testing.T) {
+			got := fmt.Sprintf(c.format, date)
+			if got != c.want {
+				t.Errorf("Sprintf(%q, %v) = %q; want %q", c.format, date, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Format_NotValid(t *testing.T) {
+	cases := []struct {
+		format string
+		date   Date
+		want   string
+	}{
+		{"%+v", Date{}, "0000-00-00"},
+		{"%+s", Date{2026, 13, 1}, "2026-13-01"},
+		{"%#v", Date{2026, 2, 30}, "date.Date{Year:2026, Month:2, Day:30}"},
+	}
+
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.date); got != c.want {
+			t.Errorf("Sprintf(%q, %#v) = %q; want %q", c.format, c.date, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestInfinity_Order(t *testing.T) {
+	dates := []Date{NegInfinity, {-9999, 1, 1}, {2026, 10, 17}, {999999, 12, 31}, Infinity}
+	for i := 1; i < len(dates); i++ {
+		if !dates[i-1].IsBefore(dates[i]) || !dates[i].IsAfter(dates[i-1]) {
+			t.Errorf("%v should be before %v", dates[i-1], dates[i])
+		}
+	}
+
+	if !Infinity.IsInfinite() || !NegInfinity.IsInfinite() || (Date{2026, 10, 17}).IsInfinite() {
+		t.Error("IsInfinite() reports wrong values")
+	}
+}
+
+func TestInfinity_String(t *testing.T) {
+	if s := Infinity.String(); s != "infinity" {
+		t.Errorf("Infinity.String() = %q; want \"infinity\"", s)
+	}
+
+	if s := NegInfinity.String(); s != "-infinity" {
+		t.Errorf("NegInfinity.String() = %q; want \"-infinity\"", s)
+	}
+
+	if s := fmt.Sprintf("%+v", Infinity); s != "infinity" {
+		t.Errorf("Sprintf(%%+v, Infinity) = %q; want \"infinity\"", s)
+	}
+}
+
+func TestInfinity_JSON(t *testing.T) {
+	for _, d := range []Date{Infinity, NegInfinity} {
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v): %v", d, err)
+		}
+
+		if want := `"` + d.String() + `"`; string(data) != want {
+			t.Errorf("json.Marshal(%v) = %s; want %s", d, data, want)
+		}
+
+		var got NullDate
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", data, err)
+		}
+
+		if got != NullDateFrom(d) {
+			t.Errorf("json.Unmarshal(%s) = %v; want %v", data, got, d)
+		}
+
+		var text Date
+		if err := text.UnmarshalText([]byte(d.String())); err != nil || text != d {
+			t.Errorf("UnmarshalText(%v) = %v, %v; want %v, <nil>", d, text, err, d)
+		}
+	}
+}
+
+func TestInfinity_SQL(t *testing.T) {
+	for _, d := range []Date{Infinity, NegInfinity} {
+		v, err := d.Value()
+		if err != nil {
+			t.Fatalf("%v.Value(): %v", d, err)
+		}
+
+		var got Date
+		if err := got.Scan(v); err != nil {
+			t.Fatalf("Scan(%#v): %v", v, err)
+		}
+
+		if got != d {
+			t.Errorf("Scan(%#v) = %v; want %v", v, got, d)
+		}
+
+		if err := got.Scan([]byte(d.String())); err != nil || got != d {
+			t.Errorf("Scan([]byte(%q)) = %v, %v; want %v, <nil>", d.String(), got, err, d)
+		}
+
+		for _, f := range []ValueFormat{ValueTime, ValueYYYYMMDD, ValueUnixDays} {
+			if v, err := f.Valuer(d).Value(); err == nil {
+				t.Errorf("%v.Valuer(%v).Value() = %#v, <nil>; want error", f, d, v)
+			}
+		}
+	}
+}
+
+func TestInfinity_Arithmetic(t *testing.T) {
+	for _, d := range []Date{Infinity, NegInfinity} {
+		for name, got := range map[string]Date{
+			"AddDays":         d.AddDays(-10),
+			"AddMonths":       d.AddMonths(3),
+			"AddYears":        d.AddYears(-1),
+			"AddBusinessDays": d.AddBusinessDays(5),
+			"FirstOfMonth":    d.FirstOfMonth(),
+			"LastOfMonth":     d.LastOfMonth(),
+			"StartOfMonth":    d.StartOfMonth(),
+			"EndOfMonth":      d.EndOfMonth(),
+			"FirstOfQuarter":  d.FirstOfQuarter(),
+			"LastOfQuarter":   d.LastOfQuarter(),
+			"FirstOfYear":     d.FirstOfYear(),
+			"LastOfYear":      d.LastOfYear(),
+			"FirstOfWeek":     d.FirstOfWeek(),
+			"LastOfWeek":      d.LastOfWeek(),
+			"EDate":           EDate(d, 2),
+			"EOMonth":         EOMonth(d, -1),
+		} {
+			if got != d {
+				t.Errorf("%v.%s() = %v; want %v", d, name, got, d)
+			}
+		}
+	}
+}
+
+func TestInfinity_DayNumbers(t *testing.T) {
+	cases := []struct {
+		date Date
+		want int
+	}{
+		{Infinity, math.MaxInt},
+		{NegInfinity, math.MinInt},
+	}
+
+	for _, c := range cases {
+		for name, got := range map[string]int{
+			"UnixDays":          c.date.UnixDays(),
+			"JulianDay":         c.date.JulianDay(),
+			"ModifiedJulianDay": c.date.ModifiedJulianDay(),
+			"RataDie":           c.date.RataDie(),
+			"SASDate":           c.date.SASDate(),
+		} {
+			if got != c.want {
+				t.Errorf("%v.%s() = %d; want %d", c.date, name, got, c.want)
+			}
+		}
+
+		if wd := c.date.Weekday(); wd != time.Sunday {
+			t.Errorf("%v.Weekday() = %v; want Sunday", c.date, wd)
+		}
+		if c.date.IsWeekend() {
+			t.Errorf("%v.IsWeekend() = true; want false", c.date)
+		}
+		if yd := c.date.YearDay(); yd != 0 {
+			t.Errorf("%v.YearDay() = %d; want 0", c.date, yd)
+		}
+		if y, w := c.date.ISOWeek(); y != 0 || w != 0 {
+			t.Errorf("%v.ISOWeek() = %d, %d; want 0, 0", c.date, y, w)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_IsValid(t *testing.T) {
+	cases := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2026, 10, 17}, true},
+		{Date{2024, 2, 29}, true},
+		{Date{2023, 2, 29}, false},
+		{Date{2023, 2, 30}, false},
+		{Date{2023, 13, 1}, false},
+		{Date{2023, 0, 1}, false},
+		{Date{2023, 1, 0}, false},
+		{Date{}, false},
+		{Infinity, true},
+		{NegInfinity, true},
+	}
+
+	for _, c := range cases {
+		if got := c.date.IsValid(); got != c.want {
+			t.Errorf("%#v.IsValid() = %t; want %t", c.date, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Normalize(t *testing.T) {
+	cases := []struct {
+		date Date
+		want Date
+	}{
+		{Date{2026, 10, 17}, Date{2026, 10, 17}},
+		{Date{2023, 2, 30}, Date{2023, 3, 2}},
+		{Date{2023, 13, 1}, Date{2024, 1, 1}},
+		{Date{2023, 0, 1}, Date{2022, 12, 1}},
+		{Date{2023, -12, 1}, Date{2021, 12, 1}},
+		{Date{2023, 1, 0}, Date{2022, 12, 31}},
+		{Date{2023, 3, -365}, Date{2022, 2, 28}},
+		{Date{2023, 12, 400}, Date{2025, 1, 3}},
+		{Date{}, Date{-1, 11, 30}},
+		{Infinity, Infinity},
+	}
+
+	for _, c := range cases {
+		got := c.date.Normalize()
+		if got != c.want {
+			t.Errorf("%#v.Normalize() = %v; want %v", c.date, got, c.want)
+		}
+
+		if !c.date.IsInfinite() {
+			if want := FromTime(time.Date(c.date.Year, c.date.Month, c.date.Day, 0, 0, 0, 0, time.UTC)); got != want {
+				t.Errorf("%#v.Normalize() = %v; time.Date gives %v", c.date, got, want)
+			}
+		}
+	}
+}
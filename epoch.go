@@ -0,0 +1,128 @@
+package date
+
+import (
+	"fmt"
+	"math"
+)
+
+// The conversions in this file count days in the proleptic Gregorian calendar
+// and are exact for years between -10^15 and 10^15. The day numbers of
+// Infinity and NegInfinity are math.MaxInt and math.MinInt.
+
+const (
+	// julianDayUnix is the Julian Day Number of 1970-01-01.
+	julianDayUnix = 2440588
+	// modifiedJulianDayUnix is the Modified Julian Day of 1970-01-01.
+	modifiedJulianDayUnix = 40587
+	// rataDieUnix is the Rata Die of 1970-01-01; day 1 is 0001-01-01.
+	rataDieUnix = 719163
+	// days1960Unix is the SAS and Stata date of 1970-01-01; day 0 is
+	// 1960-01-01.
+	days1960Unix = 3653
+)
+
+// UnixDays returns the number of days since 1970-01-01, the encoding of Avro
+// date and of Arrow and Parquet date32.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) UnixDays() int {
+	return d.daysFrom(0)
+}
+
+// daysFrom returns UnixDays plus unix, the day number of 1970-01-01 in
+// another count.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) daysFrom(unix int) int {
+	switch d {
+	case Infinity:
+		return math.MaxInt
+	case NegInfinity:
+		return math.MinInt
+	}
+
+	return daysSinceEpoch(d.Year, d.Month, d.Day) + unix
+}
+
+func FromUnixDays(days int) Date {
+	return fromDaysSinceEpoch(days)
+}
+
+// Date32 returns the Arrow and Parquet date32 value of d, or an error if it
+// does not fit in an int32 (about 5.8 million years either side of 1970).
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Date32() (int32, error) {
+	if d.Year < -6_000_000 || d.Year > 6_000_000 {
+		return 0, fmt.Errorf("date %v out of date32 range", d)
+	}
+
+	days := d.UnixDays()
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return 0, fmt.Errorf("date %v out of date32 range", d)
+	}
+
+	return int32(days), nil
+}
+
+func FromDate32(days int32) Date {
+	return FromUnixDays(int(days))
+}
+
+// JulianDay returns the Julian Day Number, counting days since 4714-11-24 BC
+// in the proleptic Gregorian calendar (-4713-11-24 in astronomical year
+// numbering). It is the number of the Julian day starting at noon on d.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) JulianDay() int {
+	return d.daysFrom(julianDayUnix)
+}
+
+func FromJulianDay(jdn int) Date {
+	return FromUnixDays(jdn - julianDayUnix)
+}
+
+// ModifiedJulianDay returns the number of days since 1858-11-17.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) ModifiedJulianDay() int {
+	return d.daysFrom(modifiedJulianDayUnix)
+}
+
+func FromModifiedJulianDay(mjd int) Date {
+	return FromUnixDays(mjd - modifiedJulianDayUnix)
+}
+
+// RataDie returns the day number with 0001-01-01 as day 1.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) RataDie() int {
+	return d.daysFrom(rataDieUnix)
+}
+
+func FromRataDie(rd int) Date {
+	return FromUnixDays(rd - rataDieUnix)
+}
+
+// SASDate returns the SAS date value, the number of days since 1960-01-01.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) SASDate() int {
+	return d.daysFrom(days1960Unix)
+}
+
+func FromSASDate(days int) Date {
+	return FromUnixDays(days - days1960Unix)
+}
+
+// StataDate returns the Stata %td value, which like SAS counts days since
+// 1960-01-01.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) StataDate() int {
+	return d.SASDate()
+}
+
+func FromStataDate(days int) Date {
+	return FromSASDate(days)
+}
@@ -0,0 +1,97 @@
+package date_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEpochConversions(t *testing.T) {
+	cases := []struct {
+		date              Date
+		unixDays          int
+		julianDay         int
+		modifiedJulianDay int
+		rataDie           int
+		sasDate           int
+	}{
+		{Date{1970, 1, 1}, 0, 2440588, 40587, 719163, 3653},
+		{Date{1960, 1, 1}, -3653, 2436935, 36934, 715510, 0},
+		{Date{1858, 11, 17}, -40587, 2400001, 0, 678576, -36934},
+		{Date{1, 1, 1}, -719162, 1721426, -678575, 1, -715509},
+		{Date{2000, 1, 1}, 10957, 2451545, 51544, 730120, 14610},
+		{Date{2026, 10, 17}, 20743, 2461331, 61330, 739906, 24396},
+		{Date{-4713, 11, 24}, -2440588, 0, -2400001, -1721425, -2436935},
+	}
+
+	for _, c := range cases {
+		t.Run(c.date.String(), func(t *testing.T) {
+			conversions := []struct {
+				name string
+				got  int
+				want int
+				from func(int) Date
+			}{
+				{"UnixDays", c.date.UnixDays(), c.unixDays, FromUnixDays},
+				{"JulianDay", c.date.JulianDay(), c.julianDay, FromJulianDay},
+				{"ModifiedJulianDay", c.date.ModifiedJulianDay(), c.modifiedJulianDay, FromModifiedJulianDay},
+				{"RataDie", c.date.RataDie(), c.rataDie, FromRataDie},
+				{"SASDate", c.date.SASDate(), c.sasDate, FromSASDate},
+				{"StataDate", c.date.StataDate(), c.sasDate, FromStataDate},
+			}
+
+			for _, conv := range conversions {
+				if conv.got != conv.want {
+					t.Errorf("%v.%s() = %d; want %d", c.date, conv.name, conv.got, conv.want)
+				}
+
+				if back := conv.from(conv.want); back != c.date {
+					t.Errorf("From%s(%d) = %v; want %v", conv.name, conv.want, back, c.date)
+				}
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestUnixDays_Time(t *testing.T) {
+	for d := (Date{1600, 1, 1}); d.Year < 2400; d = d.AddDays(97) {
+		want := int(d.Time(time.UTC).Unix() / 86400)
+		if got := d.UnixDays(); got != want {
+			t.Fatalf("%v.UnixDays() = %d; want %d", d, got, want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestUnixDays_Range(t *testing.T) {
+	for _, d := range []Date{{-1_000_000_000_000_000, 1, 1}, {1_000_000_000_000_000, 12, 31}, {-4, 2, 29}} {
+		if got := FromUnixDays(d.UnixDays()); got != d {
+			t.Errorf("FromUnixDays(%v.UnixDays()) = %v", d, got)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Date32(t *testing.T) {
+	for _, days := range []int32{math.MinInt32, -1, 0, 20743, math.MaxInt32} {
+		d := FromDate32(days)
+		got, err := d.Date32()
+		if err != nil {
+			t.Fatalf("%v.Date32(): %v", d, err)
+		}
+
+		if got != days {
+			t.Errorf("FromDate32(%d).Date32() = %d", days, got)
+		}
+	}
+
+	for _, d := range []Date{FromDate32(math.MaxInt32).AddDays(1), FromDate32(math.MinInt32).AddDays(-1), Infinity, NegInfinity} {
+		if got, err := d.Date32(); err == nil {
+			t.Errorf("%v.Date32() = %d, <nil>; want error", d, got)
+		}
+	}
+}
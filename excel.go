@@ -0,0 +1,284 @@
+package date
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ExcelSystem is a spreadsheet date system.
+type ExcelSystem int
+
+const (
+	// Excel1900 is the default system of Excel on Windows. Serial 1 is
+	// 1900-01-01, and serial 60 is 1900-02-29, a day that did not exist but
+	// that Excel keeps for compatibility with Lotus 1-2-3.
+	Excel1900 ExcelSystem = iota
+	// Excel1904 is the system of older Excel versions for Mac. Serial 0 is
+	// 1904-01-01.
+	Excel1904
+)
+
+var (
+	// excel1900Epoch is serial 0 for dates from 1900-03-01 on.
+	excel1900Epoch = daysSinceEpoch(1899, time.December, 30)
+	excel1904Epoch = daysSinceEpoch(1904, time.January, 1)
+	excelMax       = daysSinceEpoch(9999, time.December, 31)
+	excelLeapBug   = Date{1900, time.March, 1}
+)
+
+// ExcelSerial returns the serial number Excel shows for d in the given
+// system. Excel only supports dates up to 9999-12-31, and from 1900-01-01 or
+// 1904-01-01.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) ExcelSerial(system ExcelSystem) (int, error) {
+	if d.Year < 1900 || d.Year > 9999 {
+		return 0, fmt.Errorf("date %v out of Excel range", d)
+	}
+
+	days := d.UnixDays()
+	switch system {
+	case Excel1900:
+		if d.IsBefore(excelLeapBug) {
+			return days - excel1900Epoch - 1, nil
+		}
+		return days - excel1900Epoch, nil
+	case Excel1904:
+		if days < excel1904Epoch {
+			return 0, fmt.Errorf("date %v out of Excel 1904 range", d)
+		}
+		return days - excel1904Epoch, nil
+	}
+
+	return 0, fmt.Errorf("unknown ExcelSystem(%d)", int(system))
+}
+
+// FromExcelSerial returns the date of an Excel serial number. The fraction of
+// a date-time serial is the time of day and is dropped. Serial 60 in the
+// 1900 system is the nonexistent 1900-02-29 and returns an error.
+func FromExcelSerial(serial float64, system ExcelSystem) (Date, error) {
+	if math.IsNaN(serial) || serial < 0 || serial >= float64(excelMax-excel1900Epoch+1) {
+		return Date{}, fmt.Errorf("serial %v out of Excel range", serial)
+	}
+
+	n := int(serial)
+	switch system {
+	case Excel1900:
+		switch {
+		case n == 0:
+			return Date{}, fmt.Errorf("serial 0 is 1900-01-00 in Excel")
+		case n == 60:
+			return Date{}, fmt.Errorf("serial 60 is 1900-02-29 in Excel, which does not exist")
+		case n < 60:
+			return FromUnixDays(excel1900Epoch + n + 1), nil
+		}
+		return FromUnixDays(excel1900Epoch + n), nil
+	case Excel1904:
+		if d := FromUnixDays(excel1904Epoch + n); d.Year <= 9999 {
+			return d, nil
+		}
+		return Date{}, fmt.Errorf("serial %v out of Excel 1904 range", serial)
+	}
+
+	return Date{}, fmt.Errorf("unknown ExcelSystem(%d)", int(system))
+}
+
+// EDate is Excel's EDATE: the same day months later, moved back to the end of
+// the month if the month is shorter.
+func EDate(start Date, months int) Date {
+	return start.addMonthsClamped(months)
+}
+
+// EOMonth is Excel's EOMONTH: the last day of the month months after start.
+func EOMonth(start Date, months int) Date {
+	return start.FirstOfMonth().addMonthsClamped(months).LastOfMonth()
+}
+
+// NetworkDays is Excel's NETWORKDAYS: the number of days from start to end,
+// both included, that are neither a Saturday, a Sunday nor one of holidays. It
+// is negative if end is before start.
+func NetworkDays(start, end Date, holidays ...Date) int {
+	if end.IsBefore(start) {
+		return -NetworkDays(end, start, holidays...)
+	}
+
+	days := end.UnixDays() - start.UnixDays() + 1
+	count := days / 7 * 5
+	wd := start.Weekday()
+	for i := 0; i < days%7; i++ {
+		if wd := (wd + time.Weekday(i)) % 7; wd != time.Saturday && wd != time.Sunday {
+			count++
+		}
+	}
+
+	for h := range holidaySet(holidays) {
+		if !h.IsWeekend() && !h.IsBefore(start) && !h.IsAfter(end) {
+			count--
+		}
+	}
+
+	return count
+}
+
+// WorkDay is Excel's WORKDAY: the date days working days after start (before
+// it if days is negative), skipping Saturdays, Sundays and holidays. Start
+// itself is not counted, and is returned as is for 0 days.
+func WorkDay(start Date, days int, holidays ...Date) Date {
+	skip := holidaySet(holidays)
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	d := start
+	for days > 0 {
+		d = d.AddDays(step)
+		if !d.IsWeekend() && !skip[d] {
+			days--
+		}
+	}
+
+	return d
+}
+
+func holidaySet(holidays []Date) map[Date]bool {
+	set := make(map[Date]bool, len(holidays))
+	for _, h := range holidays {
+		set[h] = true
+	}
+
+	return set
+}
+
+// YearFrac is Excel's YEARFRAC: the fraction of a year between start and end
+// under a day count basis:
+//
+//   - 0: US (NASD) 30/360
+//   - 1: actual/actual
+//   - 2: actual/360
+//   - 3: actual/365
+//   - 4: European 30/360
+//
+// Like Excel, it swaps start and end if end is before start.
+func YearFrac(start, end Date, basis int) (float64, error) {
+	if end.IsBefore(start) {
+		start, end = end, start
+	}
+
+	days := float64(end.UnixDays() - start.UnixDays())
+
+	switch basis {
+	case 0:
+		sd, ed := start.Day, end.Day
+		switch {
+		case sd == 31 && ed == 31:
+			sd, ed = 30, 30
+		case sd == 31:
+			sd = 30
+		case sd == 30 && ed == 31:
+			ed = 30
+		case start.Month == time.February && end.Month == time.February && start == start.LastOfMonth() && end == end.LastOfMonth():
+			sd, ed = 30, 30
+		case start.Month == time.February && start == start.LastOfMonth():
+			sd = 30
+		}
+		return days360(start, end, sd, ed), nil
+	case 1:
+		if start.Year == end.Year || (start.Year+1 == end.Year && (start.Month > end.Month || (start.Month == end.Month && start.Day >= end.Day))) {
+			length := 365.0
+			if (start.Year == end.Year && isLeapYear(start.Year)) || feb29Between(start, end) || (end.Month == time.February && end.Day == 29) {
+				length = 366
+			}
+			return days / length, nil
+		}
+
+		years := end.Year - start.Year + 1
+		total := daysSinceEpoch(end.Year+1, time.January, 1) - daysSinceEpoch(start.Year, time.January, 1)
+		return days / (float64(total) / float64(years)), nil
+	case 2:
+		return days / 360, nil
+	case 3:
+		return days / 365, nil
+	case 4:
+		sd, ed := start.Day, end.Day
+		if sd == 31 {
+			sd = 30
+		}
+		if ed == 31 {
+			ed = 30
+		}
+		return days360(start, end, sd, ed), nil
+	}
+
+	return 0, fmt.Errorf("basis must be between 0-4 (inclusive), got %d", basis)
+}
+
+func days360(start, end Date, sd, ed int) float64 {
+	return float64((end.Year-start.Year)*360+(int(end.Month)-int(start.Month))*30+ed-sd) / 360
+}
+
+// feb29Between reports whether a February 29 in start's or end's year lies
+// in the span, the way Excel's actual/actual basis checks it.
+func feb29Between(start, end Date) bool {
+	mar1 := Date{start.Year, time.March, 1}
+	if isLeapYear(start.Year) && start.IsBefore(mar1) && !end.IsBefore(mar1) {
+		return true
+	}
+
+	mar1 = Date{end.Year, time.March, 1}
+	return isLeapYear(end.Year) && !end.IsBefore(mar1) && start.IsBefore(mar1)
+}
+
+// DateDif is Excel's DATEDIF. Unit is one of:
+//
+//   - "Y": complete years
+//   - "M": complete months
+//   - "D": days
+//   - "MD": days, ignoring months and years
+//   - "YM": months, ignoring years
+//   - "YD": days, ignoring years
+//
+// Like Excel, it returns an error if end is before start, and "MD" can be
+// negative when start is late in a month longer than the one before end.
+func DateDif(start, end Date, unit string) (int, error) {
+	if end.IsBefore(start) {
+		return 0, fmt.Errorf("end %v is before start %v", end, start)
+	}
+
+	months := (end.Year-start.Year)*12 + int(end.Month) - int(start.Month)
+	if end.Day < start.Day {
+		months--
+	}
+
+	switch unit {
+	case "Y", "y":
+		return months / 12, nil
+	case "M", "m":
+		return months, nil
+	case "D", "d":
+		return end.UnixDays() - start.UnixDays(), nil
+	case "MD", "md":
+		if end.Day >= start.Day {
+			return end.Day - start.Day, nil
+		}
+		// Excel subtracts DATE(YEAR(end), MONTH(end)-1, DAY(start)), letting
+		// the day overflow into the following month.
+		prev := end.FirstOfMonth().AddMonths(-1)
+		return end.UnixDays() - (prev.UnixDays() + start.Day - 1), nil
+	case "YM", "ym":
+		return months % 12, nil
+	case "YD", "yd":
+		year := end.Year
+		if end.Month < start.Month || (end.Month == start.Month && end.Day < start.Day) {
+			year--
+		}
+		// DATE(year, MONTH(start), DAY(start)) rolls February 29 over to
+		// March 1 in common years.
+		from := daysSinceEpoch(year, start.Month, 1) + start.Day - 1
+		return end.UnixDays() - from, nil
+	}
+
+	return 0, fmt.Errorf("unknown DATEDIF unit %q", unit)
+}
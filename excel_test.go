@@ -0,0 +1,277 @@
+package date_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_ExcelSerial(t *testing.T) {
+	cases := []struct {
+		date   Date
+		system ExcelSystem
+		want   int
+	}{
+		{Date{1900, 1, 1}, Excel1900, 1},
+		{Date{1900, 2, 28}, Excel1900, 59},
+		{Date{1900, 3, 1}, Excel1900, 61},
+		{Date{1904, 1, 1}, Excel1900, 1462},
+		{Date{2026, 10, 17}, Excel1900, 46312},
+		{Date{9999, 12, 31}, Excel1900, 2958465},
+		{Date{1904, 1, 1}, Excel1904, 0},
+		{Date{2026, 10, 17}, Excel1904, 44850},
+		{Date{9999, 12, 31}, Excel1904, 2957003},
+	}
+
+	for _, c := range cases {
+		t.Run(c.date.String(), func(t *testing.T) {
+			got, err := c.date.ExcelSerial(c.system)
+			if err != nil {
+				t.Fatalf("ExcelSerial(): %v", err)
+			}
+
+			if got != c.want {
+				t.Errorf("%v.ExcelSerial(%d) = %d; want %d", c.date, c.system, got, c.want)
+			}
+
+			back, err := FromExcelSerial(float64(c.want)+0.75, c.system)
+			if err != nil {
+				t.Fatalf("FromExcelSerial(): %v", err)
+			}
+
+			if back != c.date {
+				t.Errorf("FromExcelSerial(%d.75, %d) = %v; want %v", c.want, c.system, back, c.date)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestExcelSerial_Errors(t *testing.T) {
+	for _, d := range []Date{{1899, 12, 31}, {10000, 1, 1}, Infinity} {
+		if got, err := d.ExcelSerial(Excel1900); err == nil {
+			t.Errorf("%v.ExcelSerial(Excel1900) = %d, <nil>; want error", d, got)
+		}
+	}
+
+	if got, err := (Date{1903, 12, 31}).ExcelSerial(Excel1904); err == nil {
+		t.Errorf("ExcelSerial(Excel1904) = %d, <nil>; want error", got)
+	}
+
+	for _, serial := range []float64{0, 60, 60.5, -1, 2958466, math.NaN(), math.Inf(1)} {
+		if got, err := FromExcelSerial(serial, Excel1900); err == nil {
+			t.Errorf("FromExcelSerial(%v, Excel1900) = %v, <nil>; want error", serial, got)
+		}
+	}
+
+	if got, err := FromExcelSerial(2957004, Excel1904); err == nil {
+		t.Errorf("FromExcelSerial(2957004, Excel1904) = %v, <nil>; want error", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestExcelSerial_RoundTrip(t *testing.T) {
+	for _, system := range []ExcelSystem{Excel1900, Excel1904} {
+		first, prev := Date{1900, 1, 1}, 0
+		if system == Excel1904 {
+			first, prev = Date{1904, 1, 1}, -1
+		}
+
+		for d := first; d.Year < 2100; d = nextDay(d) {
+			serial, err := d.ExcelSerial(system)
+			if err != nil {
+				t.Fatalf("%v.ExcelSerial(%d): %v", d, system, err)
+			}
+
+			if want := prev + 1; serial != want && !(d == Date{1900, 3, 1} && serial == want+1) {
+				t.Fatalf("%v.ExcelSerial(%d) = %d; want %d", d, system, serial, want)
+			}
+			prev = serial
+
+			if back, err := FromExcelSerial(float64(serial), system); err != nil || back != d {
+				t.Fatalf("FromExcelSerial(%d, %d) = %v, %v; want %v", serial, system, back, err, d)
+			}
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEDate(t *testing.T) {
+	cases := []struct {
+		start  Date
+		months int
+		want   Date
+	}{
+		{Date{2026, 1, 31}, 1, Date{2026, 2, 28}},
+		{Date{2024, 3, 31}, -1, Date{2024, 2, 29}},
+		{Date{2026, 10, 17}, -12, Date{2025, 10, 17}},
+		{Date{2026, 10, 17}, 0, Date{2026, 10, 17}},
+	}
+
+	for _, c := range cases {
+		if got := EDate(c.start, c.months); got != c.want {
+			t.Errorf("EDate(%v, %d) = %v; want %v", c.start, c.months, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEOMonth(t *testing.T) {
+	cases := []struct {
+		start  Date
+		months int
+		want   Date
+	}{
+		{Date{2026, 1, 31}, 1, Date{2026, 2, 28}},
+		{Date{2026, 1, 15}, 1, Date{2026, 2, 28}},
+		{Date{2026, 10, 17}, -10, Date{2025, 12, 31}},
+		{Date{2024, 1, 1}, 1, Date{2024, 2, 29}},
+		{Date{2026, 10, 17}, 0, Date{2026, 10, 31}},
+	}
+
+	for _, c := range cases {
+		if got := EOMonth(c.start, c.months); got != c.want {
+			t.Errorf("EOMonth(%v, %d) = %v; want %v", c.start, c.months, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNetworkDays(t *testing.T) {
+	cases := []struct {
+		start    Date
+		end      Date
+		holidays []Date
+		want     int
+	}{
+		{Date{2026, 10, 1}, Date{2026, 10, 31}, nil, 22},
+		{Date{2026, 10, 31}, Date{2026, 10, 1}, nil, -22},
+		{Date{2026, 10, 1}, Date{2026, 10, 31}, []Date{{2026, 10, 12}, {2026, 10, 10}, {2026, 10, 12}, {2026, 11, 2}}, 21},
+		{Date{2026, 10, 17}, Date{2026, 10, 18}, nil, 0},
+		{Date{2026, 10, 16}, Date{2026, 10, 16}, nil, 1},
+		// Examples from the Excel documentation.
+		{Date{2012, 10, 1}, Date{2013, 3, 1}, nil, 110},
+		{Date{2012, 10, 1}, Date{2013, 3, 1}, []Date{{2012, 11, 22}}, 109},
+		{Date{2012, 10, 1}, Date{2013, 3, 1}, []Date{{2012, 11, 22}, {2012, 12, 4}, {2013, 1, 21}}, 107},
+	}
+
+	for _, c := range cases {
+		if got := NetworkDays(c.start, c.end, c.holidays...); got != c.want {
+			t.Errorf("NetworkDays(%v, %v, %v) = %d; want %d", c.start, c.end, c.holidays, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestWorkDay(t *testing.T) {
+	cases := []struct {
+		start    Date
+		days     int
+		holidays []Date
+		want     Date
+	}{
+		{Date{2026, 10, 16}, 1, nil, Date{2026, 10, 19}},
+		{Date{2026, 10, 17}, 1, nil, Date{2026, 10, 19}},
+		{Date{2026, 10, 19}, -1, nil, Date{2026, 10, 16}},
+		{Date{2026, 10, 17}, 0, nil, Date{2026, 10, 17}},
+		{Date{2026, 10, 16}, 1, []Date{{2026, 10, 19}}, Date{2026, 10, 20}},
+		// Examples from the Excel documentation.
+		{Date{2008, 10, 1}, 151, nil, Date{2009, 4, 30}},
+		{Date{2008, 10, 1}, 151, []Date{{2008, 11, 26}, {2008, 12, 4}, {2009, 1, 21}}, Date{2009, 5, 5}},
+	}
+
+	for _, c := range cases {
+		if got := WorkDay(c.start, c.days, c.holidays...); got != c.want {
+			t.Errorf("WorkDay(%v, %d, %v) = %v; want %v", c.start, c.days, c.holidays, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestYearFrac(t *testing.T) {
+	cases := []struct {
+		start Date
+		end   Date
+		basis int
+		want  float64
+	}{
+		// Examples from the Excel documentation.
+		{Date{2012, 1, 1}, Date{2012, 7, 30}, 0, 0.58055556},
+		{Date{2012, 1, 1}, Date{2012, 7, 30}, 1, 0.57650273},
+		{Date{2012, 1, 1}, Date{2012, 7, 30}, 3, 0.57808219},
+		{Date{2012, 7, 30}, Date{2012, 1, 1}, 3, 0.57808219},
+		{Date{2012, 1, 1}, Date{2012, 7, 30}, 2, 211.0 / 360},
+		{Date{2026, 1, 31}, Date{2026, 3, 31}, 0, 60.0 / 360},
+		{Date{2026, 1, 30}, Date{2026, 3, 31}, 0, 60.0 / 360},
+		{Date{2026, 1, 31}, Date{2026, 3, 31}, 4, 60.0 / 360},
+		{Date{2026, 1, 15}, Date{2026, 3, 31}, 4, 75.0 / 360},
+		{Date{2026, 2, 28}, Date{2027, 2, 28}, 0, 1},
+		{Date{2026, 2, 28}, Date{2026, 3, 28}, 0, 28.0 / 360},
+		{Date{2023, 6, 1}, Date{2024, 3, 1}, 1, 274.0 / 366},
+		{Date{2026, 1, 1}, Date{2027, 1, 1}, 1, 1},
+		{Date{2020, 1, 1}, Date{2022, 1, 1}, 1, 731 / (1096.0 / 3)},
+		{Date{2026, 10, 17}, Date{2026, 10, 17}, 1, 0},
+	}
+
+	for _, c := range cases {
+		got, err := YearFrac(c.start, c.end, c.basis)
+		if err != nil {
+			t.Fatalf("YearFrac(): %v", err)
+		}
+
+		if math.Abs(got-c.want) > 1e-8 {
+			t.Errorf("YearFrac(%v, %v, %d) = %.8f; want %.8f", c.start, c.end, c.basis, got, c.want)
+		}
+	}
+
+	if got, err := YearFrac(Date{2026, 1, 1}, Date{2026, 2, 1}, 5); err == nil {
+		t.Errorf("YearFrac(basis 5) = %v, <nil>; want error", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDateDif(t *testing.T) {
+	cases := []struct {
+		start Date
+		end   Date
+		unit  string
+		want  int
+	}{
+		// Examples from the Excel documentation.
+		{Date{2001, 1, 1}, Date{2003, 1, 1}, "Y", 2},
+		{Date{2001, 6, 1}, Date{2002, 8, 15}, "D", 440},
+		{Date{2001, 6, 1}, Date{2002, 8, 15}, "YD", 75},
+		{Date{2001, 6, 1}, Date{2002, 8, 15}, "MD", 14},
+		{Date{2001, 6, 1}, Date{2002, 8, 15}, "M", 14},
+		{Date{2001, 6, 1}, Date{2002, 8, 15}, "YM", 2},
+		{Date{2026, 1, 31}, Date{2026, 2, 28}, "M", 0},
+		{Date{2024, 2, 29}, Date{2025, 2, 28}, "Y", 0},
+		{Date{2024, 2, 29}, Date{2025, 3, 1}, "y", 1},
+		{Date{2026, 10, 20}, Date{2027, 10, 17}, "YD", 362},
+		{Date{2026, 10, 17}, Date{2026, 10, 17}, "D", 0},
+		// Excel's known MD quirk.
+		{Date{2015, 1, 31}, Date{2015, 3, 1}, "MD", -2},
+		{Date{2026, 1, 20}, Date{2026, 3, 5}, "md", 13},
+	}
+
+	for _, c := range cases {
+		got, err := DateDif(c.start, c.end, c.unit)
+		if err != nil {
+			t.Fatalf("DateDif(): %v", err)
+		}
+
+		if got != c.want {
+			t.Errorf("DateDif(%v, %v, %q) = %d; want %d", c.start, c.end, c.unit, got, c.want)
+		}
+	}
+
+	if got, err := DateDif(Date{2026, 10, 17}, Date{2026, 10, 16}, "D"); err == nil {
+		t.Errorf("DateDif(end before start) = %d, <nil>; want error", got)
+	}
+
+	if got, err := DateDif(Date{2026, 10, 17}, Date{2026, 10, 18}, "W"); err == nil {
+		t.Errorf("DateDif(unit W) = %d, <nil>; want error", got)
+	}
+}
@@ -0,0 +1,661 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type chunkKind int
+
+const (
+	chunkLiteral      chunkKind = iota
+	chunkLongYear               // 2006
+	chunkYear                   // 06
+	chunkNumYear                // year without padding
+	chunkLongMonth              // January
+	chunkMonth                  // Jan
+	chunkNumMonth               // 1
+	chunkZeroMonth              // 01
+	chunkLongWeekDay            // Monday
+	chunkWeekDay                // Mon
+	chunkNumWeekDay             // 0-6, Sunday is 0
+	chunkISOWeekDay             // 1-7, Monday is 1
+	chunkDay                    // 2
+	chunkUnderDay               // _2
+	chunkZeroDay                // 02
+	chunkNumYearDay             // day of year without padding
+	chunkUnderYearDay           // __2
+	chunkZeroYearDay            // 002
+	chunkISOYear                // ISO 8601 week-based year
+	chunkISOShortYear           // ISO 8601 week-based year without century
+	chunkNumISOWeek             // ISO 8601 week without padding
+	chunkZeroISOWeek            // ISO 8601 week, zero padded
+	chunkSundayWeek             // week of year, starting on the first Sunday
+	chunkMondayWeek             // week of year, starting on the first Monday
+)
+
+type chunk struct {
+	kind    chunkKind
+	literal string
+}
+
+// Layout is a compiled date layout that can be used to format and parse
+// dates repeatedly without re-reading the layout string.
+type Layout struct {
+	chunks []chunk
+	locale *Locale
+}
+
+// NewLayout compiles a Go reference-time layout such as "02/01/2006" or
+// "Jan 2, 2006". Only date components are accepted; a layout containing hours,
+// minutes, seconds, fractions, AM/PM markers or zones is rejected.
+func NewLayout(layout string) (Layout, error) {
+	chunks, err := parseGoLayout(layout)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	return Layout{chunks: chunks}, nil
+}
+
+func MustLayout(layout string) Layout {
+	l, err := NewLayout(layout)
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
+
+// WithLocale returns a copy of the layout that formats and parses month and
+// weekday names in the given locale instead of English.
+func (l Layout) WithLocale(locale *Locale) Layout {
+	l.locale = locale
+	return l
+}
+
+func (l Layout) names() *Locale {
+	if l.locale == nil {
+		return English
+	}
+
+	return l.locale
+}
+
+func (l Layout) Format(d Date) string {
+	return string(l.AppendFormat(nil, d))
+}
+
+// AppendFormat appends d formatted by the layout to b. A month outside 1-12
+// is written as time.Month.String writes it, "%!Month(13)".
+func (l Layout) AppendFormat(b []byte, d Date) []byte {
+	names := l.names()
+	for _, c := range l.chunks {
+		switch c.kind {
+		case chunkLiteral:
+			b = append(b, c.literal...)
+		case chunkLongYear:
+			b = appendInt(b, d.Year, 4)
+		case chunkYear:
+			b = appendInt(b, abs(d.Year%100), 2)
+		case chunkNumYear:
+			b = appendInt(b, d.Year, 0)
+		case chunkLongMonth:
+			b = appendMonthName(b, names.Months[:], d.Month)
+		case chunkMonth:
+			b = appendMonthName(b, names.ShortMonths[:], d.Month)
+		case chunkNumMonth:
+			b = appendInt(b, int(d.Month), 0)
+		case chunkZeroMonth:
+			b = appendInt(b, int(d.Month), 2)
+		case chunkLongWeekDay:
+			b = append(b, names.Weekdays[d.Weekday()]...)
+		case chunkWeekDay:
+			b = append(b, names.ShortWeekdays[d.Weekday()]...)
+		case chunkNumWeekDay:
+			b = appendInt(b, int(d.Weekday()), 0)
+		case chunkISOWeekDay:
+			b = appendInt(b, isoWeekday(d.Weekday()), 0)
+		case chunkDay:
+			b = appendInt(b, d.Day, 0)
+		case chunkUnderDay:
+			if d.Day < 10 {
+				b = append(b, ' ')
+			}
+			b = appendInt(b, d.Day, 0)
+		case chunkZeroDay:
+			b = appendInt(b, d.Day, 2)
+		case chunkNumYearDay:
+			b = appendInt(b, d.YearDay(), 0)
+		case chunkUnderYearDay:
+			yd := d.YearDay()
+			if yd < 100 {
+				b = append(b, ' ')
+				if yd < 10 {
+					b = append(b, ' ')
+				}
+			}
+			b = appendInt(b, yd, 0)
+		case chunkZeroYearDay:
+			b = appendInt(b, d.YearDay(), 3)
+		case chunkISOYear:
+			y, _ := d.ISOWeek()
+			b = appendInt(b, y, 4)
+		case chunkISOShortYear:
+			y, _ := d.ISOWeek()
+			b = appendInt(b, abs(y%100), 2)
+		case chunkNumISOWeek:
+			_, w := d.ISOWeek()
+			b = appendInt(b, w, 0)
+		case chunkZeroISOWeek:
+			_, w := d.ISOWeek()
+			b = appendInt(b, w, 2)
+		case chunkSundayWeek:
+			b = appendInt(b, (d.YearDay()+6-int(d.Weekday()))/7, 2)
+		case chunkMondayWeek:
+			b = appendInt(b, (d.YearDay()+6-(isoWeekday(d.Weekday())-1))/7, 2)
+		}
+	}
+
+	return b
+}
+
+func (l Layout) Parse(value string) (Date, error) {
+	f := dateFields{
+		month:      -1,
+		day:        -1,
+		yearDay:    -1,
+		weekday:    -1,
+		isoWeek:    -1,
+		sundayWeek: -1,
+		mondayWeek: -1,
+	}
+	s := value
+	names := l.names()
+
+	for i, c := range l.chunks {
+		var (
+			n   int
+			err error
+		)
+
+		switch c.kind {
+		case chunkLiteral:
+			if !strings.HasPrefix(s, c.literal) {
+				return Date{}, &ParseError{Value: value, Message: fmt.Sprintf("expected %q", c.literal)}
+			}
+			s = s[len(c.literal):]
+		case chunkLongYear, chunkISOYear:
+			neg := len(s) > 0 && s[0] == '-'
+			if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+				s = s[1:]
+			}
+			if n, s, err = getDigits(s, 4, l.longYearWidth(i)); neg {
+				n = -n
+			}
+			f.setYear(c.kind == chunkISOYear, n)
+		case chunkYear, chunkISOShortYear:
+			if n, s, err = getDigits(s, 2, 2); n >= 69 {
+				n += 1900
+			} else {
+				n += 2000
+			}
+			f.setYear(c.kind == chunkISOShortYear, n)
+		case chunkNumYear:
+			neg := len(s) > 0 && s[0] == '-'
+			if neg {
+				s = s[1:]
+			}
+			if n, s, err = getDigits(s, 1, 9); neg {
+				n = -n
+			}
+			f.year, f.hasYear = n, true
+		case chunkLongMonth:
+			n, s, err = lookupName(s, names.Months[:])
+			f.month = time.Month(n + 1)
+		case chunkMonth:
+			n, s, err = lookupName(s, names.ShortMonths[:])
+			f.month = time.Month(n + 1)
+		case chunkNumMonth, chunkZeroMonth:
+			n, s, err = getDigits(s, padding(c.kind == chunkZeroMonth, 2), 2)
+			f.month = time.Month(n)
+		case chunkLongWeekDay:
+			n, s, err = lookupName(s, names.Weekdays[:])
+			f.weekday = time.Weekday(n)
+		case chunkWeekDay:
+			n, s, err = lookupName(s, names.ShortWeekdays[:])
+			f.weekday = time.Weekday(n)
+		case chunkNumWeekDay:
+			if n, s, err = getDigits(s, 1, 1); err == nil && n > 6 {
+				err = fmt.Errorf("weekday must be between 0-6 (inclusive), got %d", n)
+			}
+			f.weekday = time.Weekday(n)
+		case chunkISOWeekDay:
+			if n, s, err = getDigits(s, 1, 1); err == nil && (n < 1 || n > 7) {
+				err = fmt.Errorf("weekday must be between 1-7 (inclusive), got %d", n)
+			}
+			f.weekday = time.Weekday(n % 7)
+		case chunkDay, chunkUnderDay, chunkZeroDay:
+			if c.kind == chunkUnderDay && len(s) > 0 && s[0] == ' ' {
+				s = s[1:]
+			}
+			f.day, s, err = getDigits(s, padding(c.kind == chunkZeroDay, 2), 2)
+		case chunkNumYearDay:
+			f.yearDay, s, err = getDigits(s, 1, 3)
+		case chunkUnderYearDay, chunkZeroYearDay:
+			width := 3
+			if c.kind == chunkUnderYearDay {
+				trimmed := strings.TrimLeft(s, " ")
+				width -= len(s) - len(trimmed)
+				if width < 1 {
+					return Date{}, &ParseError{Value: value, Message: "too many spaces before day of year"}
+				}
+				s = trimmed
+			}
+			f.yearDay, s, err = getDigits(s, width, width)
+		case chunkNumISOWeek, chunkZeroISOWeek:
+			f.isoWeek, s, err = getDigits(s, padding(c.kind == chunkZeroISOWeek, 2), 2)
+		case chunkSundayWeek:
+			f.sundayWeek, s, err = getDigits(s, 2, 2)
+		case chunkMondayWeek:
+			f.mondayWeek, s, err = getDigits(s, 2, 2)
+		}
+
+		if err != nil {
+			return Date{}, &ParseError{Value: value, Message: err.Error()}
+		}
+	}
+
+	if s != "" {
+		return Date{}, &ParseError{Value: value, Message: fmt.Sprintf("extra text %q", s)}
+	}
+
+	d, err := f.resolve()
+	if err != nil {
+		return Date{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	return d, nil
+}
+
+func appendMonthName(b []byte, names []string, m time.Month) []byte {
+	if m < time.January || m > time.December {
+		return append(b, m.String()...)
+	}
+	return append(b, names[m-1]...)
+}
+
+// checkFormat returns an error for a date that the Format methods of Date
+// cannot format, such as the zero Date.
+func checkFormat(d Date) error {
+	if !d.IsValid() {
+		return fmt.Errorf("cannot format invalid date %v", d)
+	}
+	return nil
+}
+
+// ParseError describes a value that could not be parsed into a Date.
+type ParseError struct {
+	Value   string
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cannot parse %q as date: %s", e.Value, e.Message)
+}
+
+// FormatLayout formats d with a layout of the reference date, 2006-01-02. It
+// returns an error if d is not valid.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) FormatLayout(layout string) (string, error) {
+	if err := checkFormat(d); err != nil {
+		return "", err
+	}
+
+	l, err := NewLayout(layout)
+	if err != nil {
+		return "", err
+	}
+
+	return l.Format(d), nil
+}
+
+func Parse(layout, value string) (Date, error) {
+	l, err := NewLayout(layout)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return l.Parse(value)
+}
+
+// dateFields holds the components read by Layout.Parse, where -1 means the
+// component was not present.
+type dateFields struct {
+	year       int
+	hasYear    bool
+	month      time.Month
+	day        int
+	yearDay    int
+	weekday    time.Weekday
+	isoYear    int
+	hasISOYear bool
+	isoWeek    int
+	sundayWeek int
+	mondayWeek int
+}
+
+func (f *dateFields) setYear(iso bool, year int) {
+	if iso {
+		f.isoYear, f.hasISOYear = year, true
+	} else {
+		f.year, f.hasYear = year, true
+	}
+}
+
+// resolve builds a date from the parsed components. Missing components default
+// the way time.Parse does: January, the first day of the month.
+func (f *dateFields) resolve() (Date, error) {
+	d, err := f.date()
+	if err != nil {
+		return Date{}, err
+	}
+
+	if f.hasYear && d.Year != f.year {
+		return Date{}, fmt.Errorf("%v is not in year %d", d, f.year)
+	}
+	if f.month >= 0 && d.Month != f.month {
+		return Date{}, fmt.Errorf("%v is not in month %d", d, f.month)
+	}
+	if f.day >= 0 && d.Day != f.day {
+		return Date{}, fmt.Errorf("%v is not day %d of the month", d, f.day)
+	}
+	if f.weekday >= 0 && d.Weekday() != f.weekday {
+		return Date{}, fmt.Errorf("%v is a %v, not a %v", d, d.Weekday(), f.weekday)
+	}
+
+	return d, nil
+}
+
+func (f *dateFields) date() (Date, error) {
+	switch {
+	case f.hasISOYear:
+		if f.isoWeek < 0 {
+			return Date{}, fmt.Errorf("week-based year %d requires a week number", f.isoYear)
+		}
+
+		weekday := time.Monday
+		if f.weekday >= 0 {
+			weekday = f.weekday
+		}
+
+		return fromISOWeek(f.isoYear, f.isoWeek, weekday)
+	case f.isoWeek >= 0:
+		return Date{}, fmt.Errorf("week %d requires a week-based year", f.isoWeek)
+	case f.yearDay >= 0:
+		if f.yearDay < 1 || f.yearDay > daysInYear(f.year) {
+			return Date{}, fmt.Errorf("day of year must be between 1-%d (inclusive), got %d", daysInYear(f.year), f.yearDay)
+		}
+
+		return Date{f.year, time.January, 1}.AddDays(f.yearDay - 1), nil
+	case f.month < 0 && f.day < 0 && (f.sundayWeek >= 0 || f.mondayWeek >= 0):
+		return f.fromWeekOfYear()
+	}
+
+	month, day := f.month, f.day
+	if month < 0 {
+		month = time.January
+	}
+	if day < 0 {
+		day = 1
+	}
+
+	return New(f.year, month, day)
+}
+
+// fromWeekOfYear resolves the strftime %U and %W week numbers, where week 1
+// starts on the first Sunday (or Monday) of the year and the days before it
+// are in week 0.
+func (f *dateFields) fromWeekOfYear() (Date, error) {
+	if f.weekday < 0 {
+		return Date{}, fmt.Errorf("week of year requires a weekday")
+	}
+
+	week, first, offset := f.sundayWeek, time.Sunday, int(f.weekday)
+	if week < 0 {
+		week, first, offset = f.mondayWeek, time.Monday, isoWeekday(f.weekday)-1
+	}
+
+	jan1 := Date{f.year, time.January, 1}
+	firstWeekStart := (int(first) - int(jan1.Weekday()) + 7) % 7
+	yearDay := firstWeekStart + (week-1)*7 + offset + 1
+	if yearDay < 1 || yearDay > daysInYear(f.year) {
+		return Date{}, fmt.Errorf("week %d and %v are not in year %d", week, f.weekday, f.year)
+	}
+
+	return jan1.AddDays(yearDay - 1), nil
+}
+
+func fromISOWeek(year, week int, weekday time.Weekday) (Date, error) {
+	jan4 := Date{year, time.January, 4}
+	d := jan4.AddDays(1 - isoWeekday(jan4.Weekday()) + (week-1)*7 + isoWeekday(weekday) - 1)
+	if y, w := d.ISOWeek(); y != year || w != week {
+		return Date{}, fmt.Errorf("week-based year %d has no week %d", year, week)
+	}
+
+	return d, nil
+}
+
+// isoWeekday numbers the days of the week from Monday (1) to Sunday (7).
+func isoWeekday(wd time.Weekday) int {
+	if wd == time.Sunday {
+		return 7
+	}
+
+	return int(wd)
+}
+
+func parseGoLayout(layout string) ([]chunk, error) {
+	var chunks []chunk
+	literalStart := 0
+
+	for i := 0; i < len(layout); {
+		kind, n, err := goLayoutChunk(layout[i:])
+		if err != nil {
+			return nil, fmt.Errorf("layout %q: %w", layout, err)
+		}
+
+		if n == 0 {
+			i++
+			continue
+		}
+
+		if literalStart < i {
+			chunks = append(chunks, chunk{kind: chunkLiteral, literal: layout[literalStart:i]})
+		}
+		chunks = append(chunks, chunk{kind: kind})
+		i += n
+		literalStart = i
+	}
+
+	if literalStart < len(layout) {
+		chunks = append(chunks, chunk{kind: chunkLiteral, literal: layout[literalStart:]})
+	}
+
+	return chunks, nil
+}
+
+// goLayoutChunk recognizes the layout element at the start of s, mirroring the
+// rules of the time package. It returns the length of the element, or zero if
+// s starts with literal text.
+func goLayoutChunk(s string) (chunkKind, int, error) {
+	switch s[0] {
+	case 'J':
+		if strings.HasPrefix(s, "January") {
+			return chunkLongMonth, 7, nil
+		}
+		if strings.HasPrefix(s, "Jan") {
+			return chunkMonth, 3, nil
+		}
+	case 'M':
+		if strings.HasPrefix(s, "Monday") {
+			return chunkLongWeekDay, 6, nil
+		}
+		if strings.HasPrefix(s, "Mon") {
+			return chunkWeekDay, 3, nil
+		}
+		if strings.HasPrefix(s, "MST") {
+			return 0, 0, errTimeComponent("MST")
+		}
+	case '0':
+		if len(s) >= 2 && '1' <= s[1] && s[1] <= '6' {
+			switch s[1] {
+			case '1':
+				return chunkZeroMonth, 2, nil
+			case '2':
+				return chunkZeroDay, 2, nil
+			case '6':
+				return chunkYear, 2, nil
+			default:
+				return 0, 0, errTimeComponent(s[:2])
+			}
+		}
+		if strings.HasPrefix(s, "002") {
+			return chunkZeroYearDay, 3, nil
+		}
+	case '1':
+		if strings.HasPrefix(s, "15") {
+			return 0, 0, errTimeComponent("15")
+		}
+		return chunkNumMonth, 1, nil
+	case '2':
+		if strings.HasPrefix(s, "2006") {
+			return chunkLongYear, 4, nil
+		}
+		return chunkDay, 1, nil
+	case '_':
+		if strings.HasPrefix(s, "_2") {
+			// _2006 is a literal underscore followed by a year.
+			if strings.HasPrefix(s, "_2006") {
+				return 0, 0, nil
+			}
+			return chunkUnderDay, 2, nil
+		}
+		if strings.HasPrefix(s, "__2") {
+			return chunkUnderYearDay, 3, nil
+		}
+	case '3', '4', '5':
+		return 0, 0, errTimeComponent(s[:1])
+	case 'P', 'p':
+		if len(s) >= 2 && (s[1] == 'M' || s[1] == 'm') {
+			return 0, 0, errTimeComponent(s[:2])
+		}
+	case '-', 'Z':
+		for _, zone := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+			if strings.HasPrefix(s[1:], zone) {
+				return 0, 0, errTimeComponent(s[:1+len(zone)])
+			}
+		}
+	case '.', ',':
+		if len(s) >= 2 && (s[1] == '0' || s[1] == '9') {
+			j := 1
+			for j < len(s) && s[j] == s[1] {
+				j++
+			}
+			if j == len(s) || !isDigit(s[j]) {
+				return 0, 0, errTimeComponent(s[:j])
+			}
+		}
+	}
+
+	return 0, 0, nil
+}
+
+func errTimeComponent(elem string) error {
+	return fmt.Errorf("time component %q is not allowed in a date layout", elem)
+}
+
+// lookupName matches the longest of names at the start of s, ignoring case,
+// and returns its index.
+func lookupName(s string, names []string) (int, string, error) {
+	best, bestLen := -1, 0
+	for i, name := range names {
+		if len(name) > bestLen && len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+			best, bestLen = i, len(name)
+		}
+	}
+
+	if best < 0 {
+		return 0, s, fmt.Errorf("unknown name at %q", s)
+	}
+
+	return best, s[bestLen:], nil
+}
+
+// getDigits reads between minWidth and maxWidth decimal digits from the start
+// of s.
+// longYearWidth returns the most digits the four-digit year at chunk i may
+// have when parsing. AppendFormat writes years past 9999 in full, and they can
+// be read back unless a number follows the year directly, as in "20060102".
+func (l Layout) longYearWidth(i int) int {
+	if i+1 < len(l.chunks) {
+		next := l.chunks[i+1]
+		if next.kind != chunkLiteral || next.literal == "" || isDigit(next.literal[0]) {
+			return 4
+		}
+	}
+
+	return 9
+}
+
+func getDigits(s string, minWidth, maxWidth int) (int, string, error) {
+	n := 0
+	for n < len(s) && n < maxWidth && isDigit(s[n]) {
+		n++
+	}
+
+	if n < minWidth {
+		return 0, s, fmt.Errorf("expected %d digits at %q", minWidth, s)
+	}
+
+	v, _ := strconv.Atoi(s[:n])
+	return v, s[n:], nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func padding(padded bool, width int) int {
+	if padded {
+		return width
+	}
+
+	return 1
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// appendInt appends v zero-padded to width digits.
+func appendInt(b []byte, v int, width int) []byte {
+	if v < 0 {
+		b = append(b, '-')
+		v = -v
+	}
+
+	s := strconv.Itoa(v)
+	for i := len(s); i < width; i++ {
+		b = append(b, '0')
+	}
+
+	return append(b, s...)
+}
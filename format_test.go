@@ -0,0 +1,217 @@
+package date_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_FormatLayout(t *testing.T) {
+	cases := []struct {
+		date   Date
+		layout string
+		want   string
+	}{
+		{Date{2026, 10, 17}, "2006-01-02", "2026-10-17"},
+		{Date{2026, 1, 5}, "02/01/2006", "05/01/2026"},
+		{Date{2026, 1, 5}, "Jan 2, 2006", "Jan 5, 2026"},
+		{Date{2026, 1, 5}, "January _2, 2006", "January  5, 2026"},
+		{Date{2026, 1, 5}, "20060102", "20260105"},
+		{Date{2026, 1, 5}, "1/2/06", "1/5/26"},
+		{Date{2026, 10, 17}, "Monday, 2 January 2006", "Saturday, 17 October 2026"},
+		{Date{2026, 10, 17}, "Mon Jan 02", "Sat Oct 17"},
+		{Date{2026, 2, 3}, "2006.002", "2026.034"},
+		{Date{2026, 2, 3}, "2006 __2", "2026  34"},
+		{Date{2024, 12, 31}, "2006-002", "2024-366"},
+		{Date{5, 3, 1}, "2006-01-02", "0005-03-01"},
+		{Date{2026, 1, 5}, "file_20060102", "file_20260105"},
+		{Date{2026, 1, 5}, "2006_01_02", "2026_01_05"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.layout, func(t *testing.T) {
+			got, err := c.date.FormatLayout(c.layout)
+			if err != nil {
+				t.Fatalf("%v.FormatLayout(%q): %v", c.date, c.layout, err)
+			}
+
+			if got != c.want {
+				t.Errorf("%v.FormatLayout(%q) = %q; want %q", c.date, c.layout, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_FormatLayout_Invalid(t *testing.T) {
+	for _, d := range []Date{{}, {2026, 13, 1}, {2026, 2, 30}} {
+		if got, err := d.FormatLayout("Jan 2, 2006"); err == nil {
+			t.Errorf("%v.FormatLayout() = %q, <nil>; want error", d, got)
+		}
+		if got, err := d.FormatStrftime("%B"); err == nil {
+			t.Errorf("%v.FormatStrftime() = %q, <nil>; want error", d, got)
+		}
+		if got, err := d.FormatLDML("MMMM"); err == nil {
+			t.Errorf("%v.FormatLDML() = %q, <nil>; want error", d, got)
+		}
+	}
+
+	l := MustLayout("January/Jan 2006")
+	for _, c := range []struct {
+		date Date
+		want string
+	}{
+		{Date{}, "%!Month(0)/%!Month(0) 0000"},
+		{Date{2026, 13, 1}, "%!Month(13)/%!Month(13) 2026"},
+	} {
+		if got := l.Format(c.date); got != c.want {
+			t.Errorf("Layout.Format(%v) = %q; want %q", c.date, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParse(t *testing.T) {
+	cases := []struct {
+		layout string
+		value  string
+		want   Date
+	}{
+		{"2006-01-02", "2026-10-17", Date{2026, 10, 17}},
+		{"02/01/2006", "05/01/2026", Date{2026, 1, 5}},
+		{"Jan 2, 2006", "Jan 5, 2026", Date{2026, 1, 5}},
+		{"Jan 2, 2006", "jan 5, 2026", Date{2026, 1, 5}},
+		{"January _2, 2006", "January  5, 2026", Date{2026, 1, 5}},
+		{"January _2, 2006", "January 15, 2026", Date{2026, 1, 15}},
+		{"20060102", "20260105", Date{2026, 1, 5}},
+		{"1/2/06", "1/5/26", Date{2026, 1, 5}},
+		{"1/2/06", "12/31/69", Date{1969, 12, 31}},
+		{"Monday, 2 January 2006", "Saturday, 17 October 2026", Date{2026, 10, 17}},
+		{"2006.002", "2026.034", Date{2026, 2, 3}},
+		{"2006 __2", "2026  34", Date{2026, 2, 3}},
+		{"2006-002", "2024-366", Date{2024, 12, 31}},
+		{"2006-01", "2026-10", Date{2026, 10, 1}},
+		{"2006-01-02", "-0044-03-15", Date{-44, 3, 15}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := Parse(c.layout, c.value)
+			if err != nil {
+				t.Fatalf("Parse(%q, %q): %v", c.layout, c.value, err)
+			}
+
+			if !got.Equal(c.want) {
+				t.Errorf("Parse(%q, %q) = %v; want %v", c.layout, c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		layout string
+		value  string
+	}{
+		{"day out of range", "2006-01-02", "2023-02-29"},
+		{"month out of range", "2006-01-02", "2023-13-01"},
+		{"day zero", "2006-01-02", "2023-01-00"},
+		{"missing padding", "2006-01-02", "2023-1-02"},
+		{"extra text", "2006-01-02", "2023-01-02x"},
+		{"literal mismatch", "2006-01-02", "2023/01/02"},
+		{"unknown month", "Jan 2, 2006", "Foo 2, 2006"},
+		{"wrong weekday", "Mon 2006-01-02", "Mon 2026-10-17"},
+		{"day of year out of range", "2006-002", "2023-366"},
+		{"day of year mismatch", "2006-01-02 002", "2026-02-03 035"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d, err := Parse(c.layout, c.value)
+			if err == nil {
+				t.Errorf("Parse(%q, %q) = %v, <nil>; want error", c.layout, c.value, d)
+			}
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("Parse(%q, %q) error = %v; want *ParseError", c.layout, c.value, err)
+			}
+		})
+	}
+}
+
+func TestNewLayout_TimeComponents(t *testing.T) {
+	layouts := []string{
+		"2006-01-02 15:04:05",
+		"2006-01-02 3PM",
+		"2006-01-02 03",
+		"2006-01-02T04",
+		"2006-01-02 05",
+		"2006-01-02 pm",
+		"2006-01-02 MST",
+		"2006-01-02Z07:00",
+		"2006-01-02-0700",
+		"2006-01-02.000",
+		"2006-01-02,999",
+	}
+
+	for _, layout := range layouts {
+		t.Run(layout, func(t *testing.T) {
+			if _, err := NewLayout(layout); err == nil {
+				t.Errorf("NewLayout(%q) = _, <nil>; want error", layout)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLayout_RoundTrip(t *testing.T) {
+	layout := MustLayout("Monday 02 Jan 2006 (002)")
+	for d := (Date{2023, 1, 1}); d.IsBefore(Date{2025, 1, 1}); d = d.AddDays(1) {
+		s := layout.Format(d)
+		got, err := layout.Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", s, err)
+		}
+
+		if !got.Equal(d) {
+			t.Fatalf("Parse(Format(%v)) = %v", d, got)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLayout_RoundTrip_LongYears(t *testing.T) {
+	cases := []struct {
+		layout string
+		format func(d Date, layout string) (string, error)
+		parse  func(layout, value string) (Date, error)
+	}{
+		{"2006-01-02", Date.FormatLayout, Parse},
+		{"02.01.2006", Date.FormatLayout, Parse},
+		{"%Y-%m-%d", Date.FormatStrftime, ParseStrftime},
+		{"%F", Date.FormatStrftime, ParseStrftime},
+		{"yyyy-MM-dd", Date.FormatLDML, ParseLDML},
+	}
+
+	for _, c := range cases {
+		for _, d := range []Date{{12026, 1, 2}, {999999, 12, 31}, {-44, 3, 15}, {-12026, 1, 2}, {2026, 10, 17}} {
+			s, err := c.format(d, c.layout)
+			if err != nil {
+				t.Fatalf("Format(%v, %q): %v", d, c.layout, err)
+			}
+
+			if got, err := c.parse(c.layout, s); err != nil || got != d {
+				t.Errorf("Parse(%q, %q) = %v, %v; want %v", c.layout, s, got, err, d)
+			}
+		}
+	}
+
+	// Without a separator, the year keeps four digits.
+	if got, err := Parse("20060102", "20261017"); err != nil || got != (Date{2026, 10, 17}) {
+		t.Errorf("Parse(20060102, 20261017) = %v, %v; want 2026-10-17", got, err)
+	}
+}
@@ -0,0 +1,238 @@
+package date
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// HumanPhrases holds the phrases a Humanizer produces for one language.
+type HumanPhrases struct {
+	Today     string
+	Tomorrow  string
+	Yesterday string
+	// NextWeekday and LastWeekday name a date in the coming or past six days
+	// by its weekday, indexed by time.Weekday.
+	NextWeekday [7]string
+	LastWeekday [7]string
+	// Future and Past wrap an amount, as in "in %s" and "%s ago".
+	Future string
+	Past   string
+	// Units holds the singular and plural amount patterns for UnitDay,
+	// UnitWeek, UnitMonth and UnitYear, as in "%d day" and "%d days".
+	Units map[Unit][2]string
+}
+
+var EnglishPhrases = &HumanPhrases{
+	Today:       "today",
+	Tomorrow:    "tomorrow",
+	Yesterday:   "yesterday",
+	NextWeekday: [7]string{"next Sunday", "next Monday", "next Tuesday", "next Wednesday", "next Thursday", "next Friday", "next Saturday"},
+	LastWeekday: [7]string{"last Sunday", "last Monday", "last Tuesday", "last Wednesday", "last Thursday", "last Friday", "last Saturday"},
+	Future:      "in %s",
+	Past:        "%s ago",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d day", "%d days"},
+		UnitWeek:  {"%d week", "%d weeks"},
+		UnitMonth: {"%d month", "%d months"},
+		UnitYear:  {"%d year", "%d years"},
+	},
+}
+
+var GermanPhrases = &HumanPhrases{
+	Today:       "heute",
+	Tomorrow:    "morgen",
+	Yesterday:   "gestern",
+	NextWeekday: [7]string{"nächsten Sonntag", "nächsten Montag", "nächsten Dienstag", "nächsten Mittwoch", "nächsten Donnerstag", "nächsten Freitag", "nächsten Samstag"},
+	LastWeekday: [7]string{"letzten Sonntag", "letzten Montag", "letzten Dienstag", "letzten Mittwoch", "letzten Donnerstag", "letzten Freitag", "letzten Samstag"},
+	Future:      "in %s",
+	Past:        "vor %s",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d Tag", "%d Tagen"},
+		UnitWeek:  {"%d Woche", "%d Wochen"},
+		UnitMonth: {"%d Monat", "%d Monaten"},
+		UnitYear:  {"%d Jahr", "%d Jahren"},
+	},
+}
+
+var FrenchPhrases = &HumanPhrases{
+	Today:       "aujourd’hui",
+	Tomorrow:    "demain",
+	Yesterday:   "hier",
+	NextWeekday: [7]string{"dimanche prochain", "lundi prochain", "mardi prochain", "mercredi prochain", "jeudi prochain", "vendredi prochain", "samedi prochain"},
+	LastWeekday: [7]string{"dimanche dernier", "lundi dernier", "mardi dernier", "mercredi dernier", "jeudi dernier", "vendredi dernier", "samedi dernier"},
+	Future:      "dans %s",
+	Past:        "il y a %s",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d jour", "%d jours"},
+		UnitWeek:  {"%d semaine", "%d semaines"},
+		UnitMonth: {"%d mois", "%d mois"},
+		UnitYear:  {"%d an", "%d ans"},
+	},
+}
+
+var SpanishPhrases = &HumanPhrases{
+	Today:       "hoy",
+	Tomorrow:    "mañana",
+	Yesterday:   "ayer",
+	NextWeekday: [7]string{"el próximo domingo", "el próximo lunes", "el próximo martes", "el próximo miércoles", "el próximo jueves", "el próximo viernes", "el próximo sábado"},
+	LastWeekday: [7]string{"el domingo pasado", "el lunes pasado", "el martes pasado", "el miércoles pasado", "el jueves pasado", "el viernes pasado", "el sábado pasado"},
+	Future:      "dentro de %s",
+	Past:        "hace %s",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d día", "%d días"},
+		UnitWeek:  {"%d semana", "%d semanas"},
+		UnitMonth: {"%d mes", "%d meses"},
+		UnitYear:  {"%d año", "%d años"},
+	},
+}
+
+var JapanesePhrases = &HumanPhrases{
+	Today:       "今日",
+	Tomorrow:    "明日",
+	Yesterday:   "昨日",
+	NextWeekday: [7]string{"次の日曜日", "次の月曜日", "次の火曜日", "次の水曜日", "次の木曜日", "次の金曜日", "次の土曜日"},
+	LastWeekday: [7]string{"前の日曜日", "前の月曜日", "前の火曜日", "前の水曜日", "前の木曜日", "前の金曜日", "前の土曜日"},
+	Future:      "%s後",
+	Past:        "%s前",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d日", "%d日"},
+		UnitWeek:  {"%d週間", "%d週間"},
+		UnitMonth: {"%dか月", "%dか月"},
+		UnitYear:  {"%d年", "%d年"},
+	},
+}
+
+var PortuguesePhrases = &HumanPhrases{
+	Today:       "hoje",
+	Tomorrow:    "amanhã",
+	Yesterday:   "ontem",
+	NextWeekday: [7]string{"domingo que vem", "segunda-feira que vem", "terça-feira que vem", "quarta-feira que vem", "quinta-feira que vem", "sexta-feira que vem", "sábado que vem"},
+	LastWeekday: [7]string{"domingo passado", "segunda-feira passada", "terça-feira passada", "quarta-feira passada", "quinta-feira passada", "sexta-feira passada", "sábado passado"},
+	Future:      "em %s",
+	Past:        "há %s",
+	Units: map[Unit][2]string{
+		UnitDay:   {"%d dia", "%d dias"},
+		UnitWeek:  {"%d semana", "%d semanas"},
+		UnitMonth: {"%d mês", "%d meses"},
+		UnitYear:  {"%d ano", "%d anos"},
+	},
+}
+
+// Humanizer describes a date relative to a reference date: "today",
+// "tomorrow", "3 days ago", "next Tuesday", "in 2 months". Distances shorter
+// than DayLimit days are counted in days, then in weeks until a full calendar
+// month has passed, then in months up to MonthLimit months, then in years.
+// Infinite dates have no distance: when d or the reference date is infinite,
+// the description is d.String().
+type Humanizer struct {
+	// Phrases defaults to EnglishPhrases.
+	Phrases *HumanPhrases
+	// Weekdays names dates two to six days away by their weekday instead of
+	// counting days.
+	Weekdays bool
+	// DayLimit defaults to 7, which is also its minimum, so that a distance
+	// counted in weeks is at least one week.
+	DayLimit int
+	// MonthLimit defaults to 12, which is also its minimum, so that a distance
+	// counted in years is at least one year.
+	MonthLimit int
+}
+
+// Humanize describes d relative to Today(l) in English.
+func Humanize(d Date, l *time.Location) string {
+	return Humanizer{}.FromToday(d, l)
+}
+
+func (h Humanizer) FromToday(d Date, l *time.Location) string {
+	return h.Format(d, Today(l))
+}
+
+// HumanizeContext is like Humanize, taking today's date from
+// TodayFromContext so that work done as of another date describes dates
+// relative to it.
+func HumanizeContext(ctx context.Context, d Date, l *time.Location) string {
+	return Humanizer{}.FromContext(ctx, d, l)
+}
+
+func (h Humanizer) FromContext(ctx context.Context, d Date, l *time.Location) string {
+	return h.Format(d, TodayFromContext(ctx, l))
+}
+
+func (h Humanizer) Format(d, ref Date) string {
+	p := h.Phrases
+	if p == nil {
+		p = EnglishPhrases
+	}
+
+	if d.IsInfinite() || ref.IsInfinite() {
+		return d.String()
+	}
+
+	days := DiffInDays(d, ref)
+	future := d.IsAfter(ref)
+
+	switch {
+	case days == 0:
+		return p.Today
+	case days == 1 && future:
+		return p.Tomorrow
+	case days == 1:
+		return p.Yesterday
+	case h.Weekdays && days < 7 && future:
+		return p.NextWeekday[d.Weekday()]
+	case h.Weekdays && days < 7:
+		return p.LastWeekday[d.Weekday()]
+	}
+
+	dayLimit := h.DayLimit
+	if dayLimit < 7 {
+		dayLimit = 7
+	}
+	monthLimit := h.MonthLimit
+	if monthLimit < 12 {
+		monthLimit = 12
+	}
+
+	var (
+		n    int
+		unit Unit
+	)
+
+	months := abs(monthsBetween(ref, d))
+	switch {
+	case days < dayLimit:
+		n, unit = days, UnitDay
+	case months == 0:
+		n, unit = days/7, UnitWeek
+	case months < monthLimit:
+		n, unit = months, UnitMonth
+	default:
+		n, unit = months/12, UnitYear
+	}
+
+	patterns := p.Units[unit]
+	amount := fmt.Sprintf(patterns[1], n)
+	if n == 1 {
+		amount = fmt.Sprintf(patterns[0], n)
+	}
+
+	if future {
+		return fmt.Sprintf(p.Future, amount)
+	}
+
+	return fmt.Sprintf(p.Past, amount)
+}
+
+// monthsBetween returns the number of full calendar months from a to b,
+// negative if b is before a.
+func monthsBetween(a, b Date) int {
+	months := (b.Year-a.Year)*12 + int(b.Month) - int(a.Month)
+	if months > 0 && b.Day < a.Day {
+		months--
+	} else if months < 0 && b.Day > a.Day {
+		months++
+	}
+
+	return months
+}
@@ -0,0 +1,90 @@
+package date_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestHumanizer_Format(t *testing.T) {
+	ref := Date{2026, 10, 17} // Saturday
+
+	cases := []struct {
+		name      string
+		humanizer Humanizer
+		date      Date
+		want      string
+	}{
+		{"today", Humanizer{}, ref, "today"},
+		{"tomorrow", Humanizer{}, Date{2026, 10, 18}, "tomorrow"},
+		{"yesterday", Humanizer{}, Date{2026, 10, 16}, "yesterday"},
+		{"days ahead", Humanizer{}, Date{2026, 10, 20}, "in 3 days"},
+		{"days ago", Humanizer{}, Date{2026, 10, 14}, "3 days ago"},
+		{"next weekday", Humanizer{Weekdays: true}, Date{2026, 10, 20}, "next Tuesday"},
+		{"last weekday", Humanizer{Weekdays: true}, Date{2026, 10, 11}, "last Sunday"},
+		{"weekday limit", Humanizer{Weekdays: true}, Date{2026, 10, 24}, "in 1 week"},
+		{"one week", Humanizer{}, Date{2026, 10, 24}, "in 1 week"},
+		{"weeks", Humanizer{}, Date{2026, 11, 10}, "in 3 weeks"},
+		{"weeks before a full month", Humanizer{}, Date{2026, 11, 16}, "in 4 weeks"},
+		{"one month", Humanizer{}, Date{2026, 11, 17}, "in 1 month"},
+		{"months", Humanizer{}, Date{2026, 12, 20}, "in 2 months"},
+		{"months ago", Humanizer{}, Date{2026, 5, 1}, "5 months ago"},
+		{"one year", Humanizer{}, Date{2027, 10, 17}, "in 1 year"},
+		{"years ago", Humanizer{}, Date{2023, 1, 1}, "3 years ago"},
+		{"day limit", Humanizer{DayLimit: 30}, Date{2026, 11, 10}, "in 24 days"},
+		{"month limit", Humanizer{MonthLimit: 24}, Date{2027, 12, 17}, "in 14 months"},
+		{"day limit below a week", Humanizer{DayLimit: 3}, Date{2026, 10, 22}, "in 5 days"},
+		{"month limit below a year", Humanizer{MonthLimit: 3}, Date{2027, 3, 17}, "in 5 months"},
+		{"infinity", Humanizer{}, Infinity, "infinity"},
+		{"negative infinity", Humanizer{}, NegInfinity, "-infinity"},
+		{"German", Humanizer{Phrases: GermanPhrases}, Date{2026, 10, 14}, "vor 3 Tagen"},
+		{"German weekday", Humanizer{Phrases: GermanPhrases, Weekdays: true}, Date{2026, 10, 20}, "nächsten Dienstag"},
+		{"French", Humanizer{Phrases: FrenchPhrases}, Date{2026, 12, 20}, "dans 2 mois"},
+		{"Spanish", Humanizer{Phrases: SpanishPhrases}, Date{2025, 10, 1}, "hace 1 año"},
+		{"Japanese", Humanizer{Phrases: JapanesePhrases}, Date{2026, 10, 20}, "3日後"},
+		{"Portuguese", Humanizer{Phrases: PortuguesePhrases, Weekdays: true}, Date{2026, 10, 13}, "terça-feira passada"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.humanizer.Format(c.date, ref)
+			if got != c.want {
+				t.Errorf("Format(%v, %v) = %q; want %q", c.date, ref, got, c.want)
+			}
+		})
+	}
+
+	if got := (Humanizer{}).Format(ref, Infinity); got != "2026-10-17" {
+		t.Errorf("Format(%v, infinity) = %q; want \"2026-10-17\"", ref, got)
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	today := Today(time.UTC)
+	if got := Humanize(today.AddDays(1), time.UTC); got != "tomorrow" {
+		t.Errorf("Humanize(today+1) = %q; want \"tomorrow\"", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestHumanizeContext(t *testing.T) {
+	ctx := WithAsOf(context.Background(), Date{2026, 10, 17})
+
+	if got := HumanizeContext(ctx, Date{2026, 10, 14}, time.UTC); got != "3 days ago" {
+		t.Errorf("HumanizeContext(2026-10-14) = %q; want \"3 days ago\"", got)
+	}
+
+	h := Humanizer{Weekdays: true}
+	if got := h.FromContext(ctx, Date{2026, 10, 20}, time.UTC); got != "next Tuesday" {
+		t.Errorf("FromContext(2026-10-20) = %q; want \"next Tuesday\"", got)
+	}
+
+	clock := NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	ctx = WithClock(context.Background(), clock)
+	if got := HumanizeContext(ctx, Date{2026, 1, 2}, time.UTC); got != "tomorrow" {
+		t.Errorf("HumanizeContext(2026-01-02) with a clock = %q; want \"tomorrow\"", got)
+	}
+}
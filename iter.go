@@ -0,0 +1,160 @@
+package date
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// The sequences in this file run from from to to, both included, and run
+// backwards when to is before from. They yield nothing if from is infinite,
+// and never end if to is.
+
+// Overflow says what a monthly or yearly sequence does in a month that is too
+// short for its day.
+type Overflow int
+
+const (
+	// OverflowClamp uses the last day of the month, so the 31st of every
+	// month gives every month end.
+	OverflowClamp Overflow = iota
+	// OverflowSkip leaves the month out.
+	OverflowSkip
+	// OverflowSpill carries the extra days into the next month like AddMonths:
+	// the 31st of February is March 3 or 2.
+	OverflowSpill
+)
+
+func (o Overflow) String() string {
+	switch o {
+	case OverflowClamp:
+		return "OverflowClamp"
+	case OverflowSkip:
+		return "OverflowSkip"
+	case OverflowSpill:
+		return "OverflowSpill"
+	}
+
+	return fmt.Sprintf("Overflow(%d)", int(o))
+}
+
+// date returns the given day of a month, if o allows one.
+func (o Overflow) date(year int, month time.Month, day int) (Date, bool) {
+	last := daysInMonth(year, month)
+	if day <= last {
+		return Date{year, month, day}, true
+	}
+
+	switch o {
+	case OverflowSkip:
+		return Date{}, false
+	case OverflowSpill:
+		return Date{year, month, 1}.AddDays(day - 1), true
+	}
+	return Date{year, month, last}, true
+}
+
+// All returns the days of r in order.
+func (r Range) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if r.End.IsBefore(r.Start) {
+			return
+		}
+
+		for d := range EachDay(r.Start, r.End) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// EachDay returns every day from from to to.
+func EachDay(from, to Date) iter.Seq[Date] {
+	if to.IsBefore(from) {
+		return eachStep(from, to, -1)
+	}
+	return eachStep(from, to, 1)
+}
+
+// EachWeekday returns every weekday from from to to, such as every Monday.
+func EachWeekday(from, to Date, weekday time.Weekday) iter.Seq[Date] {
+	if to.IsBefore(from) {
+		return eachStep(from.AddDays(-((int(from.Weekday()-weekday) + 7) % 7)), to, -7)
+	}
+	return eachStep(from.AddDays((int(weekday-from.Weekday())+7)%7), to, 7)
+}
+
+// eachStep yields start and every step days after it, as long as the dates
+// have not passed to.
+func eachStep(start, to Date, step int) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if start.IsInfinite() {
+			return
+		}
+
+		for d := start; (step > 0 && !d.IsAfter(to)) || (step < 0 && !d.IsBefore(to)); d = d.AddDays(step) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// EachMonth returns the day of every month from from to to, handling months
+// without that day as overflow says. It panics if day is not between 1 and
+// 31.
+func EachMonth(from, to Date, day int, overflow Overflow) iter.Seq[Date] {
+	if day < 1 || day > 31 {
+		panic(fmt.Sprintf("date: day must be between 1-31 (inclusive), got %d", day))
+	}
+
+	return eachMonths(from, to, from.FirstOfMonth(), 1, func(first Date) (Date, bool) {
+		return overflow.date(first.Year, first.Month, day)
+	})
+}
+
+// EachYear returns month and day of every year from from to to, handling
+// February 29 in common years as overflow says. It panics if the month has
+// no such day even in leap years.
+func EachYear(from, to Date, month time.Month, day int, overflow Overflow) iter.Seq[Date] {
+	if month < time.January || month > time.December || day < 1 || day > daysInMonth(2000, month) {
+		panic(fmt.Sprintf("date: invalid month and day %02d-%02d", month, day))
+	}
+
+	return eachMonths(from, to, Date{from.Year, month, 1}, 12, func(first Date) (Date, bool) {
+		return overflow.date(first.Year, first.Month, day)
+	})
+}
+
+// eachMonths calls pick with start, the first of a month, and with the first
+// of every months-th month after it, or before it if to is before from. It
+// yields the dates picked that lie between from and to.
+func eachMonths(from, to, start Date, months int, pick func(first Date) (Date, bool)) iter.Seq[Date] {
+	lo, hi := from, to
+	if to.IsBefore(from) {
+		lo, hi, months = to, from, -months
+	}
+
+	return func(yield func(Date) bool) {
+		if from.IsInfinite() {
+			return
+		}
+
+		for first := start; ; first = first.AddMonths(months) {
+			// OverflowSpill can pick a day early in the following month.
+			if (months > 0 && first.IsAfter(hi)) || (months < 0 && first.AddMonths(1).LastOfMonth().IsBefore(lo)) {
+				return
+			}
+
+			d, ok := pick(first)
+			if !ok || d.IsBefore(lo) || d.IsAfter(hi) {
+				continue
+			}
+
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package date_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEachDay(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to Date
+		want     []Date
+	}{
+		{"forward", Date{2024, 2, 27}, Date{2024, 3, 1}, []Date{{2024, 2, 27}, {2024, 2, 28}, {2024, 2, 29}, {2024, 3, 1}}},
+		{"backward", Date{2026, 1, 1}, Date{2025, 12, 30}, []Date{{2026, 1, 1}, {2025, 12, 31}, {2025, 12, 30}}},
+		{"single", Date{2026, 10, 18}, Date{2026, 10, 18}, []Date{{2026, 10, 18}}},
+		{"infinite start", NegInfinity, Date{2026, 10, 18}, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := slices.Collect(EachDay(c.from, c.to)); !slices.Equal(got, c.want) {
+				t.Errorf("EachDay(%v, %v) = %v; want %v", c.from, c.to, got, c.want)
+			}
+		})
+	}
+
+	var got []Date
+	for d := range EachDay(Date{2026, 10, 18}, Infinity) {
+		if len(got) == 3 {
+			break
+		}
+		got = append(got, d)
+	}
+
+	if want := []Date{{2026, 10, 18}, {2026, 10, 19}, {2026, 10, 20}}; !slices.Equal(got, want) {
+		t.Errorf("EachDay(…, Infinity) with break = %v; want %v", got, want)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRange_All(t *testing.T) {
+	r := Range{Date{2026, 10, 30}, Date{2026, 11, 1}}
+	if got, want := slices.Collect(r.All()), []Date{{2026, 10, 30}, {2026, 10, 31}, {2026, 11, 1}}; !slices.Equal(got, want) {
+		t.Errorf("All() = %v; want %v", got, want)
+	}
+
+	if got := slices.Collect(Range{r.End, r.Start}.All()); len(got) != 0 {
+		t.Errorf("All() of an empty range = %v; want none", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEachWeekday(t *testing.T) {
+	cases := []struct {
+		from, to Date
+		weekday  time.Weekday
+		want     []Date
+	}{
+		{Date{2026, 10, 1}, Date{2026, 10, 31}, time.Monday, []Date{{2026, 10, 5}, {2026, 10, 12}, {2026, 10, 19}, {2026, 10, 26}}},
+		{Date{2026, 10, 5}, Date{2026, 10, 19}, time.Monday, []Date{{2026, 10, 5}, {2026, 10, 12}, {2026, 10, 19}}},
+		{Date{2026, 10, 31}, Date{2026, 10, 10}, time.Saturday, []Date{{2026, 10, 31}, {2026, 10, 24}, {2026, 10, 17}, {2026, 10, 10}}},
+		{Date{2026, 10, 30}, Date{2026, 10, 1}, time.Sunday, []Date{{2026, 10, 25}, {2026, 10, 18}, {2026, 10, 11}, {2026, 10, 4}}},
+		{Date{2026, 10, 13}, Date{2026, 10, 18}, time.Monday, nil},
+	}
+
+	for _, c := range cases {
+		if got := slices.Collect(EachWeekday(c.from, c.to, c.weekday)); !slices.Equal(got, c.want) {
+			t.Errorf("EachWeekday(%v, %v, %v) = %v; want %v", c.from, c.to, c.weekday, got, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEachMonth(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to Date
+		day      int
+		overflow Overflow
+		want     []Date
+	}{
+		{"month ends", Date{2024, 1, 1}, Date{2024, 4, 30}, 31, OverflowClamp, []Date{{2024, 1, 31}, {2024, 2, 29}, {2024, 3, 31}, {2024, 4, 30}}},
+		{"skip", Date{2026, 1, 1}, Date{2026, 5, 31}, 31, OverflowSkip, []Date{{2026, 1, 31}, {2026, 3, 31}, {2026, 5, 31}}},
+		{"spill", Date{2026, 1, 1}, Date{2026, 3, 31}, 31, OverflowSpill, []Date{{2026, 1, 31}, {2026, 3, 3}, {2026, 3, 31}}},
+		{"bounds", Date{2026, 1, 16}, Date{2026, 4, 14}, 15, OverflowClamp, []Date{{2026, 2, 15}, {2026, 3, 15}}},
+		{"backward", Date{2026, 4, 30}, Date{2026, 1, 31}, 31, OverflowClamp, []Date{{2026, 4, 30}, {2026, 3, 31}, {2026, 2, 28}, {2026, 1, 31}}},
+		{"backward spill", Date{2026, 3, 10}, Date{2026, 3, 1}, 31, OverflowSpill, []Date{{2026, 3, 3}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := slices.Collect(EachMonth(c.from, c.to, c.day, c.overflow)); !slices.Equal(got, c.want) {
+				t.Errorf("EachMonth(%v, %v, %d, %v) = %v; want %v", c.from, c.to, c.day, c.overflow, got, c.want)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("EachMonth(day 32) should panic")
+		}
+	}()
+	EachMonth(Date{2026, 1, 1}, Date{2026, 12, 31}, 32, OverflowClamp)
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestEachYear(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to Date
+		overflow Overflow
+		want     []Date
+	}{
+		{"clamp", Date{2023, 1, 1}, Date{2025, 12, 31}, OverflowClamp, []Date{{2023, 2, 28}, {2024, 2, 29}, {2025, 2, 28}}},
+		{"skip", Date{2023, 1, 1}, Date{2029, 1, 1}, OverflowSkip, []Date{{2024, 2, 29}, {2028, 2, 29}}},
+		{"spill", Date{2023, 3, 2}, Date{2025, 3, 1}, OverflowSpill, []Date{{2024, 2, 29}, {2025, 3, 1}}},
+		{"spill into range", Date{2023, 3, 1}, Date{2023, 3, 1}, OverflowSpill, []Date{{2023, 3, 1}}},
+		{"backward", Date{2025, 2, 27}, Date{2023, 1, 1}, OverflowClamp, []Date{{2024, 2, 29}, {2023, 2, 28}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := slices.Collect(EachYear(c.from, c.to, time.February, 29, c.overflow)); !slices.Equal(got, c.want) {
+				t.Errorf("EachYear(%v, %v, 02-29, %v) = %v; want %v", c.from, c.to, c.overflow, got, c.want)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("EachYear(04-31) should panic")
+		}
+	}()
+	EachYear(Date{2026, 1, 1}, Date{2030, 1, 1}, time.April, 31, OverflowClamp)
+}
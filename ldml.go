@@ -0,0 +1,179 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewLDMLLayout compiles a Unicode LDML (CLDR, ICU, Java DateTimeFormatter)
+// pattern such as "dd.MM.yyyy" or "EEEE, d MMMM y". Supported fields are
+// y, yy, yyyy (year), Y, YY, YYYY (week-based year), M/L, MM/LL, MMM/LLL,
+// MMMM/LLLL (month), w, ww (week of week-based year), d, dd (day of month),
+// D, DDD (day of year), E..EEE, EEEE (weekday name) and e (weekday number,
+// Monday is 1). Text in single quotes is literal and a doubled single quote is
+// an apostrophe. Weeks follow ISO 8601 regardless of locale. Time fields are
+// rejected.
+func NewLDMLLayout(pattern string) (Layout, error) {
+	var (
+		chunks  []chunk
+		literal strings.Builder
+	)
+
+	flush := func() {
+		if literal.Len() > 0 {
+			chunks = append(chunks, chunk{kind: chunkLiteral, literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		if c == '\'' {
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				literal.WriteByte('\'')
+				i += 2
+				continue
+			}
+
+			end := i + 1
+			for ; ; end++ {
+				if end == len(pattern) {
+					return Layout{}, fmt.Errorf("LDML pattern %q: unterminated quote", pattern)
+				}
+				if pattern[end] == '\'' {
+					if end+1 < len(pattern) && pattern[end+1] == '\'' {
+						literal.WriteByte('\'')
+						end++
+						continue
+					}
+					break
+				}
+				literal.WriteByte(pattern[end])
+			}
+
+			i = end + 1
+			continue
+		}
+
+		if !isASCIILetter(c) {
+			literal.WriteByte(c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+
+		kind, err := ldmlField(c, n)
+		if err != nil {
+			return Layout{}, fmt.Errorf("LDML pattern %q: %w", pattern, err)
+		}
+
+		flush()
+		chunks = append(chunks, chunk{kind: kind})
+		i += n
+	}
+
+	flush()
+	return Layout{chunks: chunks}, nil
+}
+
+func ldmlField(c byte, n int) (chunkKind, error) {
+	field := strings.Repeat(string(c), n)
+
+	switch c {
+	case 'y', 'u':
+		switch n {
+		case 1:
+			return chunkNumYear, nil
+		case 2:
+			return chunkYear, nil
+		case 4:
+			return chunkLongYear, nil
+		}
+	case 'Y':
+		switch n {
+		case 2:
+			return chunkISOShortYear, nil
+		case 1, 4:
+			return chunkISOYear, nil
+		}
+	case 'M', 'L':
+		switch n {
+		case 1:
+			return chunkNumMonth, nil
+		case 2:
+			return chunkZeroMonth, nil
+		case 3:
+			return chunkMonth, nil
+		case 4:
+			return chunkLongMonth, nil
+		}
+	case 'w':
+		switch n {
+		case 1:
+			return chunkNumISOWeek, nil
+		case 2:
+			return chunkZeroISOWeek, nil
+		}
+	case 'd':
+		switch n {
+		case 1:
+			return chunkDay, nil
+		case 2:
+			return chunkZeroDay, nil
+		}
+	case 'D':
+		switch n {
+		case 1:
+			return chunkNumYearDay, nil
+		case 3:
+			return chunkZeroYearDay, nil
+		}
+	case 'E':
+		switch {
+		case n <= 3:
+			return chunkWeekDay, nil
+		case n == 4:
+			return chunkLongWeekDay, nil
+		}
+	case 'e', 'c':
+		if n == 1 {
+			return chunkISOWeekDay, nil
+		}
+	case 'a', 'b', 'B', 'h', 'H', 'k', 'K', 'm', 's', 'S', 'A', 'z', 'Z', 'O', 'v', 'V', 'X', 'x', 'n', 'N':
+		return 0, errTimeComponent(field)
+	}
+
+	return 0, fmt.Errorf("field %q is not supported", field)
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) FormatLDML(pattern string) (string, error) {
+	if err := checkFormat(d); err != nil {
+		return "", err
+	}
+
+	l, err := NewLDMLLayout(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return l.Format(d), nil
+}
+
+func ParseLDML(pattern, value string) (Date, error) {
+	l, err := NewLDMLLayout(pattern)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return l.Parse(value)
+}
@@ -0,0 +1,100 @@
+package date_test
+
+import (
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_FormatLDML(t *testing.T) {
+	cases := []struct {
+		date    Date
+		pattern string
+		want    string
+	}{
+		{Date{2026, 10, 17}, "yyyy-MM-dd", "2026-10-17"},
+		{Date{2026, 1, 5}, "dd.MM.yyyy", "05.01.2026"},
+		{Date{2026, 1, 5}, "d.M.yy", "5.1.26"},
+		{Date{2026, 1, 5}, "d MMM y", "5 Jan 2026"},
+		{Date{2026, 10, 17}, "EEEE, d MMMM yyyy", "Saturday, 17 October 2026"},
+		{Date{2026, 10, 17}, "EEE LLL", "Sat Oct"},
+		{Date{2027, 1, 1}, "YYYY-'W'ww-e", "2026-W53-5"},
+		{Date{2024, 12, 30}, "YY w", "25 1"},
+		{Date{2026, 2, 3}, "yyyy.DDD D", "2026.034 34"},
+		{Date{2026, 10, 17}, "'Quote: ''' yyyy ''", "Quote: ' 2026 '"},
+		{Date{2026, 10, 17}, "yyyy年M月d日", "2026年10月17日"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.pattern, func(t *testing.T) {
+			got, err := c.date.FormatLDML(c.pattern)
+			if err != nil {
+				t.Fatalf("%v.FormatLDML(%q): %v", c.date, c.pattern, err)
+			}
+
+			if got != c.want {
+				t.Errorf("%v.FormatLDML(%q) = %q; want %q", c.date, c.pattern, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseLDML(t *testing.T) {
+	cases := []struct {
+		pattern string
+		value   string
+		want    Date
+	}{
+		{"yyyy-MM-dd", "2026-10-17", Date{2026, 10, 17}},
+		{"dd.MM.yyyy", "05.01.2026", Date{2026, 1, 5}},
+		{"d.M.y", "5.1.2026", Date{2026, 1, 5}},
+		{"d MMM y", "5 Jan 2026", Date{2026, 1, 5}},
+		{"EEEE, d MMMM yyyy", "Saturday, 17 October 2026", Date{2026, 10, 17}},
+		{"YYYY-'W'ww-e", "2026-W53-5", Date{2027, 1, 1}},
+		{"YYYY-'W'ww-EEE", "2026-W01-Mon", Date{2025, 12, 29}},
+		{"yyyy.DDD", "2026.034", Date{2026, 2, 3}},
+		{"yyyy年M月d日", "2026年10月17日", Date{2026, 10, 17}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParseLDML(c.pattern, c.value)
+			if err != nil {
+				t.Fatalf("ParseLDML(%q, %q): %v", c.pattern, c.value, err)
+			}
+
+			if !got.Equal(c.want) {
+				t.Errorf("ParseLDML(%q, %q) = %v; want %v", c.pattern, c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestNewLDMLLayout_Errors(t *testing.T) {
+	patterns := []string{
+		"yyyy-MM-dd HH:mm",
+		"yyyy-MM-dd'T'hh a",
+		"yyyy-MM-dd XXX",
+		"yyyy-MM-dd 'unterminated",
+		"GGGG yyyy",
+		"MMMMM",
+		"yyy",
+	}
+
+	for _, pattern := range patterns {
+		t.Run(pattern, func(t *testing.T) {
+			if _, err := NewLDMLLayout(pattern); err == nil {
+				t.Errorf("NewLDMLLayout(%q) = _, <nil>; want error", pattern)
+			}
+		})
+	}
+}
+
+func TestParseLDML_WeekYearWithoutWeek(t *testing.T) {
+	// A common mistake is using YYYY (week-based year) where yyyy was meant.
+	if d, err := ParseLDML("YYYY-MM-dd", "2026-12-31"); err == nil {
+		t.Errorf("ParseLDML(\"YYYY-MM-dd\", \"2026-12-31\") = %v, <nil>; want error", d)
+	}
+}
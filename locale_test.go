@@ -0,0 +1,117 @@
+package date_test
+
+import (
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLayout_WithLocale(t *testing.T) {
+	cases := []struct {
+		locale *Locale
+		layout string
+		date   Date
+		want   string
+	}{
+		{English, "Monday, 2 January 2006", Date{2026, 10, 17}, "Saturday, 17 October 2026"},
+		{German, "Monday, 2. January 2006", Date{2026, 10, 17}, "Samstag, 17. Oktober 2026"},
+		{German, "Mon 2. Jan 2006", Date{2026, 3, 2}, "Mo. 2. März 2026"},
+		{French, "Monday 2 January 2006", Date{2026, 8, 13}, "jeudi 13 août 2026"},
+		{French, "Mon 2 Jan 2006", Date{2026, 2, 3}, "mar. 3 févr. 2026"},
+		{Spanish, "Monday, 2 de January de 2006", Date{2026, 10, 14}, "miércoles, 14 de octubre de 2026"},
+		{Japanese, "2006年January2日(Mon)", Date{2026, 10, 17}, "2026年10月17日(土)"},
+		{Portuguese, "Monday, 2 de January de 2006", Date{2026, 3, 3}, "terça-feira, 3 de março de 2026"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.locale.Tag+" "+c.want, func(t *testing.T) {
+			layout := MustLayout(c.layout).WithLocale(c.locale)
+
+			got := layout.Format(c.date)
+			if got != c.want {
+				t.Errorf("Format(%v) = %q; want %q", c.date, got, c.want)
+			}
+
+			parsed, err := layout.Parse(c.want)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", c.want, err)
+			}
+
+			if !parsed.Equal(c.date) {
+				t.Errorf("Parse(%q) = %v; want %v", c.want, parsed, c.date)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLayout_WithLocale_Parse(t *testing.T) {
+	cases := []struct {
+		locale *Locale
+		layout string
+		value  string
+		want   Date
+	}{
+		{German, "2. January 2006", "17. Oktober 2026", Date{2026, 10, 17}},
+		{German, "2. January 2006", "17. oktober 2026", Date{2026, 10, 17}},
+		{French, "2 January 2006", "1 JUILLET 2026", Date{2026, 7, 1}},
+		{French, "2 Jan 2006", "1 juil. 2026", Date{2026, 7, 1}},
+		{Japanese, "2006年January2日", "2026年1月17日", Date{2026, 1, 17}},
+		{Japanese, "2006年January2日", "2026年11月17日", Date{2026, 11, 17}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := MustLayout(c.layout).WithLocale(c.locale).Parse(c.value)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", c.value, err)
+			}
+
+			if !got.Equal(c.want) {
+				t.Errorf("Parse(%q) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLayout_WithLocale_LDML(t *testing.T) {
+	layout, err := NewLDMLLayout("EEEE d. MMMM y")
+	if err != nil {
+		t.Fatalf("NewLDMLLayout(): %v", err)
+	}
+
+	want := "Samstag 17. Oktober 2026"
+	if got := layout.WithLocale(German).Format(Date{2026, 10, 17}); got != want {
+		t.Errorf("Format() = %q; want %q", got, want)
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	cases := []struct {
+		tag  string
+		want *Locale
+	}{
+		{"en", English},
+		{"de-DE", German},
+		{"DE_at", German},
+		{"fr-CA", French},
+		{"es-419", Spanish},
+		{"ja-JP", Japanese},
+		{"pt-BR", Portuguese},
+	}
+
+	for _, c := range cases {
+		t.Run(c.tag, func(t *testing.T) {
+			got, ok := LookupLocale(c.tag)
+			if !ok || got != c.want {
+				t.Errorf("LookupLocale(%q) = %p, %v; want %p, true", c.tag, got, ok, c.want)
+			}
+		})
+	}
+
+	if l, ok := LookupLocale("xx"); ok {
+		t.Errorf("LookupLocale(\"xx\") = %p, true; want nil, false", l)
+	}
+}
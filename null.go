@@ -1,10 +1,9 @@
 package date
 
 import (
-	"bytes"
 	"database/sql/driver"
+	"errors"
 	"fmt"
-	"time"
 )
 
 var nullBytes = []byte("null")
@@ -18,48 +17,130 @@ func NullDateFrom(date Date) NullDate {
 	return NullDate{Valid: true, Date: date}
 }
 
+// NullDateFromPtr returns a null date for a nil d, and *d otherwise.
+func NullDateFromPtr(d *Date) NullDate {
+	return nullDate(FromPtr(d))
+}
+
+// Null converts d to the generic Null type.
+func (d NullDate) Null() Null[Date] {
+	return Null[Date]{Valid: d.Valid, V: d.Date}
+}
+
+func nullDate(n Null[Date]) NullDate {
+	return NullDate{Valid: n.Valid, Date: n.V}
+}
+
+// Ptr returns nil for a null date, and a pointer to a copy of Date otherwise.
+func (d NullDate) Ptr() *Date {
+	return d.Null().Ptr()
+}
+
+// ValueOr returns Date, or v if d is null.
+func (d NullDate) ValueOr(v Date) Date {
+	return d.Null().ValueOr(v)
+}
+
+// IsZero reports whether d is null, so that fields tagged omitzero are left
+// out of JSON.
+func (d NullDate) IsZero() bool {
+	return !d.Valid
+}
+
 func (d NullDate) MarshalJSON() ([]byte, error) {
-	if !d.Valid {
-		return nullBytes, nil
-	}
-	return d.Date.MarshalJSON()
+	return d.Null().MarshalJSON()
 }
 
 func (d *NullDate) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, nullBytes) {
-		d.Valid = false
-		d.Date = Date{}
-		return nil
+	var n Null[Date]
+	if err := n.UnmarshalJSON(data); err != nil {
+		return err
 	}
 
-	if err := d.Date.UnmarshalJSON(data); err != nil {
+	*d = nullDate(n)
+	return nil
+}
+
+func (d NullDate) MarshalText() ([]byte, error) {
+	return d.Null().MarshalText()
+}
+
+func (d *NullDate) UnmarshalText(data []byte) error {
+	var n Null[Date]
+	if err := n.UnmarshalText(data); err != nil {
 		return err
 	}
 
-	d.Valid = true
+	*d = nullDate(n)
 	return nil
 }
 
-func (d NullDate) Value() (value driver.Value, err error) {
-	if d.Valid {
-		return d.Date.Value()
+// MarshalBinary encodes a null date as a single zero byte and a valid date as
+// a one byte followed by the binary form of Date.
+func (d NullDate) MarshalBinary() ([]byte, error) {
+	if !d.Valid {
+		return []byte{0}, nil
 	}
-	return nil, nil
+	if err := d.Date.checkMarshal("MarshalBinary"); err != nil {
+		return nil, err
+	}
+	return d.Date.appendBinary([]byte{1}), nil
 }
 
-func (d *NullDate) Scan(value any) error {
-	if value == nil {
-		d.Valid = false
-		return nil
+func (d *NullDate) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("NullDate.UnmarshalBinary: no data")
 	}
 
-	if t, ok := value.(time.Time); ok {
-		if err := d.Date.Scan(t); err != nil {
+	switch data[0] {
+	case 0:
+		if len(data) != 1 {
+			return errors.New("NullDate.UnmarshalBinary: invalid length")
+		}
+		d.Valid = false
+		d.Date = Date{}
+		return nil
+	case 1:
+		if err := d.Date.UnmarshalBinary(data[1:]); err != nil {
 			return err
 		}
 		d.Valid = true
 		return nil
 	}
 
-	return fmt.Errorf("cannot scan type %T into NullTime", value)
+	return fmt.Errorf("NullDate.UnmarshalBinary: invalid validity byte %d", data[0])
+}
+
+func (d NullDate) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+func (d *NullDate) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// Format implements fmt.Formatter with the verbs of Date.Format. A null date
+// prints as "null".
+func (d NullDate) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		writePadded(s, fmt.Sprintf("date.NullDate{Valid:%t, Date:%#v}", d.Valid, d.Date))
+		return
+	}
+
+	if !d.Valid {
+		writePadded(s, "null")
+		return
+	}
+
+	d.Date.Format(s, verb)
+}
+
+// Value returns nil for a null date, or the date in DefaultValueFormat.
+func (d NullDate) Value() (value driver.Value, err error) {
+	return DefaultValueFormat.NullValuer(d).Value()
+}
+
+// Scan accepts nil and the driver values allowed by DefaultScanOptions.
+func (d *NullDate) Scan(value any) error {
+	return DefaultScanOptions.NullScanner(d).Scan(value)
 }
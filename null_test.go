@@ -158,3 +158,104 @@ func TestNullDate_Value(t *testing.T) {
 		})
 	}
 }
+
+func TestNullDate_MarshalText(t *testing.T) {
+	cases := []struct {
+		date NullDate
+		want string
+	}{
+		{NullDate{}, ""},
+		{NullDateFrom(Date{2026, 10, 17}), "2026-10-17"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.want, func(t *testing.T) {
+			got, err := c.date.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText(): %v", err)
+			}
+
+			if string(got) != c.want {
+				t.Errorf("MarshalText() = %q, <nil>; want %q, <nil>", got, c.want)
+			}
+
+			var parsed NullDate
+			if err := parsed.UnmarshalText(got); err != nil {
+				t.Fatalf("UnmarshalText(%q): %v", got, err)
+			}
+
+			if parsed != c.date {
+				t.Errorf("UnmarshalText(%q) = %v; want %v", got, parsed, c.date)
+			}
+		})
+	}
+}
+
+func TestNullDate_MarshalBinary(t *testing.T) {
+	cases := []NullDate{
+		{},
+		NullDateFrom(Date{2026, 10, 17}),
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c), func(t *testing.T) {
+			data, err := c.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary(): %v", err)
+			}
+
+			got := NullDate{Valid: true, Date: Date{1, 1, 1}}
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary(%v): %v", data, err)
+			}
+
+			if got != c {
+				t.Errorf("UnmarshalBinary(MarshalBinary(%v)) = %v", c, got)
+			}
+		})
+	}
+}
+
+func TestNullDate_UnmarshalBinary_Errors(t *testing.T) {
+	cases := [][]byte{
+		nil,
+		{0, 1},
+		{2},
+		{1},
+		{1, 1, 0xcc, 0x1f, 2, 30},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v", c), func(t *testing.T) {
+			var d NullDate
+			if err := d.UnmarshalBinary(c); err == nil {
+				t.Errorf("UnmarshalBinary(%v) = <nil>; want error", c)
+			}
+		})
+	}
+}
+
+func TestNullDate_Format(t *testing.T) {
+	cases := []struct {
+		format string
+		date   NullDate
+		want   string
+	}{
+		{"%v", NullDate{}, "null"},
+		{"%6s|", NullDate{}, "  null|"},
+		{"%v", NullDateFrom(Date{2026, 10, 17}), "2026-10-17"},
+		{"%+v", NullDateFrom(Date{2026, 10, 17}), "Saturday, October 17, 2026"},
+		{"%d", NullDateFrom(Date{2026, 10, 17}), "261017"},
+		{"%#v", NullDate{}, "date.NullDate{Valid:false, Date:date.Date{Year:0, Month:0, Day:0}}"},
+		{"%+v", NullDate{Valid: true}, "0000-00-00"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.format, func(t *testing.T) {
+			got := fmt.Sprintf(c.format, c.date)
+			if got != c.want {
+				t.Errorf("Sprintf(%q, %#v) = %q; want %q", c.format, c.date, got, c.want)
+			}
+		})
+	}
+}
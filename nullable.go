@@ -0,0 +1,150 @@
+package date
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Null is a T that may be null, for any of the package's types. It marshals
+// to JSON null, empty text and SQL NULL when not Valid. T's own JSON, text and
+// SQL methods are used otherwise; a T without text methods cannot be
+// marshaled as text, and a T that is not an sql.Scanner only scans values of
+// its own type.
+type Null[T any] struct {
+	Valid bool
+	V     T
+}
+
+func NullFrom[T any](v T) Null[T] {
+	return Null[T]{Valid: true, V: v}
+}
+
+// FromPtr returns a null value for a nil p, and *p otherwise.
+func FromPtr[T any](p *T) Null[T] {
+	if p == nil {
+		return Null[T]{}
+	}
+	return NullFrom(*p)
+}
+
+// Ptr returns nil for a null value, and a pointer to a copy of V otherwise.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+
+	v := n.V
+	return &v
+}
+
+// ValueOr returns V, or v if n is null.
+func (n Null[T]) ValueOr(v T) T {
+	if !n.Valid {
+		return v
+	}
+	return n.V
+}
+
+// IsZero reports whether n is null, so that fields tagged omitzero are left
+// out of JSON.
+func (n Null[T]) IsZero() bool {
+	return !n.Valid
+}
+
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return nullBytes, nil
+	}
+	return json.Marshal(n.V)
+}
+
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*n = NullFrom(v)
+	return nil
+}
+
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	m, ok := any(n.V).(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement encoding.TextMarshaler", n.V)
+	}
+
+	return m.MarshalText()
+}
+
+func (n *Null[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	u, ok := any(&v).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("%T does not implement encoding.TextUnmarshaler", &v)
+	}
+
+	if err := u.UnmarshalText(data); err != nil {
+		return err
+	}
+
+	*n = NullFrom(v)
+	return nil
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if v, ok := any(n.V).(driver.Valuer); ok {
+		return v.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+func (n *Null[T]) Scan(value any) error {
+	if value == nil {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(value); err != nil {
+			return err
+		}
+
+		*n = NullFrom(v)
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if !rv.Type().AssignableTo(reflect.TypeOf(&v).Elem()) {
+		return fmt.Errorf("cannot scan type %T into Null[%T]", value, v)
+	}
+
+	reflect.ValueOf(&v).Elem().Set(rv)
+	*n = NullFrom(v)
+	return nil
+}
@@ -0,0 +1,192 @@
+package date_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNull_JSON(t *testing.T) {
+	type doc struct {
+		Date   Null[Date]       `json:"date"`
+		Month  Null[GYearMonth] `json:"month"`
+		Count  Null[int]        `json:"count"`
+		Absent Null[Date]       `json:"absent"`
+	}
+
+	in := doc{
+		Date:  NullFrom(Date{2026, 10, 17}),
+		Month: NullFrom(GYearMonth{Year: 2026, Month: time.October}),
+		Count: NullFrom(3),
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+
+	want := `{"date":"2026-10-17","month":"2026-10","count":3,"absent":null}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s; want %s", data, want)
+	}
+
+	out := doc{Absent: NullFrom(Date{2000, 1, 1})}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+
+	if out != in {
+		t.Errorf("json.Unmarshal() = %+v; want %+v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":"2026-02-30"}`), &out); err == nil {
+		t.Error("json.Unmarshal(2026-02-30) = <nil>; want error")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNull_Text(t *testing.T) {
+	n := NullFrom(GMonthDay{Month: time.February, Day: 29})
+	data, err := n.MarshalText()
+	if err != nil || string(data) != "--02-29" {
+		t.Errorf("MarshalText() = %q, %v; want \"--02-29\", <nil>", data, err)
+	}
+
+	var back Null[GMonthDay]
+	if err := back.UnmarshalText(data); err != nil || back != n {
+		t.Errorf("UnmarshalText(%q) = %v, %v; want %v", data, back, err, n)
+	}
+
+	if err := back.UnmarshalText(nil); err != nil || back.Valid {
+		t.Errorf("UnmarshalText(empty) = %v, %v; want null", back, err)
+	}
+
+	if data, err := (Null[Date]{}).MarshalText(); err != nil || len(data) != 0 {
+		t.Errorf("null MarshalText() = %q, %v; want \"\", <nil>", data, err)
+	}
+
+	if _, err := NullFrom(struct{}{}).MarshalText(); err == nil {
+		t.Error("MarshalText() of a type without text methods = <nil>; want error")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNull_SQL(t *testing.T) {
+	var d Null[Date]
+	if err := queryOne(t, "2026-10-17", &d); err != nil || d != NullFrom(Date{2026, 10, 17}) {
+		t.Errorf("Scan() = %v, %v; want 2026-10-17", d, err)
+	}
+
+	if err := queryOne(t, nil, &d); err != nil || d.Valid {
+		t.Errorf("Scan(nil) = %v, %v; want null", d, err)
+	}
+
+	var r Null[PGDateRange]
+	if err := queryOne(t, "[2026-01-01,2026-01-31]", &r); err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	if v, err := r.Value(); err != nil || v != "[2026-01-01,2026-02-01)" {
+		t.Errorf("Value() = %v, %v; want [2026-01-01,2026-02-01)", v, err)
+	}
+
+	var i Null[int64]
+	if err := queryOne(t, int64(42), &i); err != nil || i != NullFrom(int64(42)) {
+		t.Errorf("Scan(42) = %v, %v; want 42", i, err)
+	}
+
+	if err := queryOne(t, "42", &i); err == nil {
+		t.Error("Scan(\"42\") into Null[int64] = <nil>; want error")
+	}
+
+	for _, c := range []struct {
+		valuer driver.Valuer
+		want   driver.Value
+	}{
+		{NullFrom(Date{2026, 10, 17}), "2026-10-17"},
+		{Null[Date]{}, nil},
+		{NullFrom(int32(7)), int64(7)},
+	} {
+		if got, err := c.valuer.Value(); err != nil || got != c.want {
+			t.Errorf("Value() = %#v, %v; want %#v", got, err, c.want)
+		}
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNull_Helpers(t *testing.T) {
+	d := Date{2026, 10, 17}
+	fallback := Date{2000, 1, 1}
+
+	if got := NullFrom(d).ValueOr(fallback); got != d {
+		t.Errorf("ValueOr() = %v; want %v", got, d)
+	}
+	if got := (Null[Date]{}).ValueOr(fallback); got != fallback {
+		t.Errorf("null ValueOr() = %v; want %v", got, fallback)
+	}
+
+	if p := (Null[Date]{}).Ptr(); p != nil {
+		t.Errorf("null Ptr() = %v; want nil", p)
+	}
+
+	n := NullFrom(d)
+	p := n.Ptr()
+	if p == nil || *p != d {
+		t.Fatalf("Ptr() = %v; want pointer to %v", p, d)
+	}
+
+	*p = fallback
+	if n.V != d {
+		t.Error("Ptr() should return a copy")
+	}
+
+	if got := FromPtr(&d); got != NullFrom(d) {
+		t.Errorf("FromPtr(&d) = %v; want %v", got, NullFrom(d))
+	}
+	if got := FromPtr[Date](nil); got.Valid {
+		t.Errorf("FromPtr(nil) = %v; want null", got)
+	}
+
+	if !(Null[Date]{}).IsZero() || NullFrom(Date{}).IsZero() {
+		t.Error("IsZero() should report only null values")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNullDate_Helpers(t *testing.T) {
+	d := Date{2026, 10, 17}
+	fallback := Date{2000, 1, 1}
+
+	if got := NullDateFrom(d).ValueOr(fallback); got != d {
+		t.Errorf("ValueOr() = %v; want %v", got, d)
+	}
+	if got := (NullDate{}).ValueOr(fallback); got != fallback {
+		t.Errorf("null ValueOr() = %v; want %v", got, fallback)
+	}
+
+	if p := NullDateFrom(d).Ptr(); p == nil || *p != d {
+		t.Errorf("Ptr() = %v; want pointer to %v", p, d)
+	}
+	if p := (NullDate{}).Ptr(); p != nil {
+		t.Errorf("null Ptr() = %v; want nil", p)
+	}
+
+	if got := NullDateFromPtr(&d); got != NullDateFrom(d) {
+		t.Errorf("NullDateFromPtr(&d) = %v; want %v", got, NullDateFrom(d))
+	}
+	if got := NullDateFromPtr(nil); got.Valid {
+		t.Errorf("NullDateFromPtr(nil) = %v; want null", got)
+	}
+
+	if !(NullDate{}).IsZero() || NullDateFrom(Date{}).IsZero() {
+		t.Error("IsZero() should report only null dates")
+	}
+
+	if got := NullDateFrom(d).Null(); got != NullFrom(d) {
+		t.Errorf("Null() = %v; want %v", got, NullFrom(d))
+	}
+}
@@ -0,0 +1,360 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Packed is a Date in four bytes: the number of days since 1970-01-01, as
+// returned by UnixDays. Packed values order with < and >, compare with == and
+// make compact map keys. Every Packed is a valid date; Infinity and
+// NegInfinity are PackedInfinity and PackedNegInfinity, the largest and
+// smallest values.
+//
+// Packed has the methods of Date that return or take dates, working on the
+// packed form where it is cheap. Call Date for anything else.
+type Packed int32
+
+const (
+	PackedInfinity    Packed = math.MaxInt32
+	PackedNegInfinity Packed = math.MinInt32
+)
+
+// Pack returns d as a Packed, or an error if d is not valid or is more than
+// about 5.8 million years from 1970.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) Pack() (Packed, error) {
+	switch {
+	case d == Infinity:
+		return PackedInfinity, nil
+	case d == NegInfinity:
+		return PackedNegInfinity, nil
+	case !d.IsValid():
+		return 0, fmt.Errorf("date %v is not valid", d)
+	}
+
+	days, err := d.Date32()
+	if err != nil || days == math.MaxInt32 || days == math.MinInt32 {
+		return 0, fmt.Errorf("date %v out of Packed range", d)
+	}
+
+	return Packed(days), nil
+}
+
+// MustPack is like Pack but panics on error.
+func MustPack(d Date) Packed {
+	p, err := d.Pack()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Date() Date {
+	switch p {
+	case PackedInfinity:
+		return Infinity
+	case PackedNegInfinity:
+		return NegInfinity
+	}
+
+	return fromDaysSinceEpoch(int(p))
+}
+
+// packSaturated packs the result of date arithmetic, which is valid but may
+// be out of range, as the infinity on its side.
+func packSaturated(d Date) Packed {
+	p, err := d.Pack()
+	if err == nil {
+		return p
+	}
+
+	if d.Year < 1970 {
+		return PackedNegInfinity
+	}
+	return PackedInfinity
+}
+
+// UnixDays is like Date.UnixDays: it is math.MaxInt and math.MinInt for
+// PackedInfinity and PackedNegInfinity.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) UnixDays() int {
+	switch p {
+	case PackedInfinity:
+		return math.MaxInt
+	case PackedNegInfinity:
+		return math.MinInt
+	}
+	return int(p)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) IsInfinite() bool {
+	return p == PackedInfinity || p == PackedNegInfinity
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) IsBefore(o Packed) bool {
+	return p < o
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) IsAfter(o Packed) bool {
+	return p > o
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Equal(o Packed) bool {
+	return p == o
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Time(l *time.Location) time.Time {
+	return p.Date().Time(l)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) StartOfDay(l *time.Location) time.Time {
+	return p.Date().StartOfDay(l)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) EndOfDay(l *time.Location) time.Time {
+	return p.Date().EndOfDay(l)
+}
+
+// AddDays adds days without unpacking. Results past the range of Packed
+// saturate at PackedInfinity or PackedNegInfinity.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) AddDays(days int) Packed {
+	if p.IsInfinite() {
+		return p
+	}
+
+	switch n := int(p) + days; {
+	case n >= math.MaxInt32:
+		return PackedInfinity
+	case n <= math.MinInt32:
+		return PackedNegInfinity
+	default:
+		return Packed(n)
+	}
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) AddMonths(months int) Packed {
+	return packSaturated(p.Date().AddMonths(months))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) AddYears(years int) Packed {
+	return packSaturated(p.Date().AddYears(years))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) AddBusinessDays(n int) Packed {
+	return packSaturated(p.Date().AddBusinessDays(n))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) FirstOfMonth() Packed {
+	return packSaturated(p.Date().FirstOfMonth())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) LastOfMonth() Packed {
+	return packSaturated(p.Date().LastOfMonth())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) StartOfMonth() Packed {
+	return packSaturated(p.Date().StartOfMonth())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) EndOfMonth() Packed {
+	return packSaturated(p.Date().EndOfMonth())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Quarter() int {
+	return p.Date().Quarter()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) FirstOfQuarter() Packed {
+	return packSaturated(p.Date().FirstOfQuarter())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) LastOfQuarter() Packed {
+	return packSaturated(p.Date().LastOfQuarter())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) FirstOfYear() Packed {
+	return packSaturated(p.Date().FirstOfYear())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) LastOfYear() Packed {
+	return packSaturated(p.Date().LastOfYear())
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) FirstOfWeek() Packed {
+	if p.IsInfinite() {
+		return p
+	}
+	return p.AddDays(-((int(p.Weekday()) + 6) % 7))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) LastOfWeek() Packed {
+	if p.IsInfinite() {
+		return p
+	}
+	return p.AddDays((7 - int(p.Weekday())) % 7)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Weekday() time.Weekday {
+	if p.IsInfinite() {
+		return time.Sunday
+	}
+	// 1970-01-01 was a Thursday.
+	return time.Weekday((int(p)%7 + 7 + int(time.Thursday)) % 7)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) IsWeekend() bool {
+	if p.IsInfinite() {
+		return false
+	}
+
+	wd := p.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) YearDay() int {
+	return p.Date().YearDay()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) ISOWeek() (year, week int) {
+	return p.Date().ISOWeek()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) FormatLayout(layout string) (string, error) {
+	return p.Date().FormatLayout(layout)
+}
+
+// Format implements fmt.Formatter with the verbs of Date.Format, except that
+// %#v prints the packed value.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		writePadded(s, fmt.Sprintf("date.Packed(%d)", int32(p)))
+		return
+	}
+
+	p.Date().Format(s, verb)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) String() string {
+	return p.Date().String()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) ShortString() string {
+	return p.Date().ShortString()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) MarshalJSON() ([]byte, error) {
+	return p.Date().MarshalJSON()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p *Packed) UnmarshalJSON(data []byte) error {
+	var d Date
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	return p.set(d)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) MarshalText() ([]byte, error) {
+	return p.Date().MarshalText()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p *Packed) UnmarshalText(data []byte) error {
+	var d Date
+	if err := d.UnmarshalText(data); err != nil {
+		return err
+	}
+	return p.set(d)
+}
+
+// MarshalBinary uses the encoding of Date.MarshalBinary, so that the two
+// types can read each other's data.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) MarshalBinary() ([]byte, error) {
+	return p.Date().MarshalBinary()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p *Packed) UnmarshalBinary(data []byte) error {
+	var d Date
+	if err := d.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return p.set(d)
+}
+
+// Value returns the date in DefaultValueFormat.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Value() (driver.Value, error) {
+	return p.Date().Value()
+}
+
+// Scan accepts the driver values allowed by DefaultScanOptions.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (p *Packed) Scan(value any) error {
+	var d Date
+	if err := d.Scan(value); err != nil {
+		return err
+	}
+	return p.set(d)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p *Packed) set(d Date) error {
+	packed, err := d.Pack()
+	if err != nil {
+		return err
+	}
+
+	*p = packed
+	return nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (p Packed) Compare(o Packed) int {
+	return compareInts(int(p), int(o))
+}
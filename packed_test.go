@@ -0,0 +1,219 @@
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+	"unsafe"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Pack(t *testing.T) {
+	cases := []struct {
+		date Date
+		want Packed
+	}{
+		{Date{1970, 1, 1}, 0},
+		{Date{1969, 12, 31}, -1},
+		{Date{2026, 10, 17}, 20743},
+		{Date{1, 1, 1}, -719162},
+		{Infinity, PackedInfinity},
+		{NegInfinity, PackedNegInfinity},
+	}
+
+	for _, c := range cases {
+		got, err := c.date.Pack()
+		if err != nil || got != c.want {
+			t.Errorf("%v.Pack() = %d, %v; want %d", c.date, got, err, c.want)
+		}
+
+		if back := got.Date(); back != c.date {
+			t.Errorf("Packed(%d).Date() = %v; want %v", got, back, c.date)
+		}
+	}
+
+	for _, d := range []Date{{2026, 2, 30}, {2026, 13, 1}, {}, {6_000_000, 1, 1}, {-6_000_000, 1, 1}} {
+		if got, err := d.Pack(); err == nil {
+			t.Errorf("%v.Pack() = %d, <nil>; want error", d, got)
+		}
+	}
+
+	if size := unsafe.Sizeof(Packed(0)); size != 4 {
+		t.Errorf("unsafe.Sizeof(Packed) = %d; want 4", size)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPacked_Order(t *testing.T) {
+	dates := []Date{{2026, 10, 17}, Infinity, {1969, 12, 31}, {2026, 1, 1}, NegInfinity, {-44, 3, 15}, {2026, 10, 16}}
+
+	packed := make([]Packed, len(dates))
+	for i, d := range dates {
+		packed[i] = MustPack(d)
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].IsBefore(dates[j]) })
+	sort.Slice(packed, func(i, j int) bool { return packed[i] < packed[j] })
+
+	for i := range dates {
+		if packed[i].Date() != dates[i] {
+			t.Errorf("sorted[%d] = %v; want %v", i, packed[i], dates[i])
+		}
+	}
+
+	seen := map[Packed]bool{MustPack(Date{2026, 10, 17}): true}
+	if !seen[MustPack(Date{2026, 10, 17})] {
+		t.Error("Packed should work as a map key")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPacked_MatchesDate(t *testing.T) {
+	for d := (Date{2023, 12, 1}); d.IsBefore(Date{2025, 3, 1}); d = nextDay(d) {
+		checkPackedMatchesDate(t, d)
+	}
+
+	checkPackedMatchesDate(t, Infinity)
+	checkPackedMatchesDate(t, NegInfinity)
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func checkPackedMatchesDate(t *testing.T, d Date) {
+	t.Helper()
+
+	p := MustPack(d)
+
+	same := []struct {
+		name string
+		got  Packed
+		want Date
+	}{
+		{"AddDays", p.AddDays(-40), d.AddDays(-40)},
+		{"AddMonths", p.AddMonths(13), d.AddMonths(13)},
+		{"AddYears", p.AddYears(-1), d.AddYears(-1)},
+		{"AddBusinessDays", p.AddBusinessDays(3), d.AddBusinessDays(3)},
+		{"FirstOfMonth", p.FirstOfMonth(), d.FirstOfMonth()},
+		{"LastOfMonth", p.LastOfMonth(), d.LastOfMonth()},
+		{"StartOfMonth", p.StartOfMonth(), d.StartOfMonth()},
+		{"EndOfMonth", p.EndOfMonth(), d.EndOfMonth()},
+		{"FirstOfQuarter", p.FirstOfQuarter(), d.FirstOfQuarter()},
+		{"LastOfQuarter", p.LastOfQuarter(), d.LastOfQuarter()},
+		{"FirstOfYear", p.FirstOfYear(), d.FirstOfYear()},
+		{"LastOfYear", p.LastOfYear(), d.LastOfYear()},
+		{"FirstOfWeek", p.FirstOfWeek(), d.FirstOfWeek()},
+		{"LastOfWeek", p.LastOfWeek(), d.LastOfWeek()},
+	}
+
+	for _, c := range same {
+		if c.got.Date() != c.want {
+			t.Fatalf("%v.%s() = %v; want %v", d, c.name, c.got, c.want)
+		}
+	}
+
+	if p.Weekday() != d.Weekday() || p.IsWeekend() != d.IsWeekend() || p.YearDay() != d.YearDay() || p.Quarter() != d.Quarter() {
+		t.Fatalf("%v: weekday, year day or quarter differ from Date", d)
+	}
+
+	y, w := p.ISOWeek()
+	if dy, dw := d.ISOWeek(); y != dy || w != dw {
+		t.Fatalf("%v.ISOWeek() = %d, %d; want %d, %d", d, y, w, dy, dw)
+	}
+
+	if !p.Time(time.UTC).Equal(d.Time(time.UTC)) || p.String() != d.String() || p.UnixDays() != d.UnixDays() {
+		t.Fatalf("%v: Time, String or UnixDays differ from Date", d)
+	}
+}
+
+func TestPacked_Saturates(t *testing.T) {
+	if got := PackedInfinity.AddDays(-10); got != PackedInfinity {
+		t.Errorf("PackedInfinity.AddDays(-10) = %v; want infinity", got)
+	}
+
+	if got := (PackedInfinity - 1).AddDays(1); got != PackedInfinity {
+		t.Errorf("AddDays past the end = %v; want infinity", got)
+	}
+
+	if got := (PackedNegInfinity + 1).AddYears(-1); got != PackedNegInfinity {
+		t.Errorf("AddYears past the start = %v; want -infinity", got)
+	}
+
+	if got := PackedNegInfinity.AddMonths(1); got != PackedNegInfinity {
+		t.Errorf("PackedNegInfinity.AddMonths(1) = %v; want -infinity", got)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPacked_Encoding(t *testing.T) {
+	type doc struct {
+		From Packed `json:"from"`
+		To   Packed `json:"to"`
+	}
+
+	in := doc{MustPack(Date{2026, 10, 17}), PackedInfinity}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+
+	if want := `{"from":"2026-10-17","to":"infinity"}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s; want %s", data, want)
+	}
+
+	var out doc
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("json.Unmarshal() = %v, %v; want %v", out, err, in)
+	}
+
+	bin, err := in.From.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %v", err)
+	}
+
+	var d Date
+	if err := d.UnmarshalBinary(bin); err != nil || d != (Date{2026, 10, 17}) {
+		t.Errorf("Date.UnmarshalBinary(Packed.MarshalBinary()) = %v, %v", d, err)
+	}
+
+	var p Packed
+	if err := queryOne(t, "2026-10-17", &p); err != nil || p != in.From {
+		t.Errorf("Scan() = %v, %v; want %v", p, err, in.From)
+	}
+
+	if v, err := p.Value(); err != nil || v != "2026-10-17" {
+		t.Errorf("Value() = %v, %v; want 2026-10-17", v, err)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPacked_Format(t *testing.T) {
+	p := MustPack(Date{2026, 10, 17})
+
+	for _, c := range []struct {
+		format string
+		want   string
+	}{
+		{"%v", "2026-10-17"},
+		{"%+v", "Saturday, October 17, 2026"},
+		{"%#v", "date.Packed(20743)"},
+	} {
+		if got := fmt.Sprintf(c.format, p); got != c.want {
+			t.Errorf("Sprintf(%q, %d) = %q; want %q", c.format, int32(p), got, c.want)
+		}
+	}
+
+	if got, err := p.FormatLayout("Jan 2, 2006"); err != nil || got != "Oct 17, 2026" {
+		t.Errorf("FormatLayout() = %q, %v; want \"Oct 17, 2026\"", got, err)
+	}
+}
+
+func BenchmarkPacked_AddDays(b *testing.B) {
+	p := MustPack(Date{Year: 2026, Month: 10, Day: 17})
+	for i := 0; i < b.N; i++ {
+		p = p.AddDays(1)
+	}
+}
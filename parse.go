@@ -0,0 +1,334 @@
+package date
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ZonePolicy decides which calendar date a timestamp with a UTC offset
+// represents.
+type ZonePolicy int
+
+const (
+	// ZoneWall uses the date as written and ignores the offset.
+	ZoneWall ZonePolicy = iota
+	// ZoneUTC converts the timestamp to UTC before taking the date.
+	ZoneUTC
+	// ZoneLocation converts the timestamp to a configured location before
+	// taking the date.
+	ZoneLocation
+)
+
+func (z ZonePolicy) time(t time.Time, l *time.Location) time.Time {
+	switch z {
+	case ZoneUTC:
+		return t.UTC()
+	case ZoneLocation:
+		if l == nil {
+			l = time.UTC
+		}
+		return t.In(l)
+	}
+
+	return t
+}
+
+// Parser parses ISO 8601 dates. The zero value is strict and accepts exactly
+// "YYYY-MM-DD", like FromISO8601; the options relax it.
+type Parser struct {
+	// TrimSpace ignores leading and trailing white space.
+	TrimSpace bool
+	// AllowCompact accepts the basic format "YYYYMMDD".
+	AllowCompact bool
+	// AllowUnpadded accepts one-digit months and days, as in "2026-1-5".
+	AllowUnpadded bool
+	// AllowTimestamp accepts a time of day after the date, separated by "T"
+	// or a space, as in RFC 3339 "2026-10-17T00:00:00Z" or SQL
+	// "2026-10-17 00:00:00". Zone decides how an offset is applied;
+	// timestamps without an offset always use the date as written.
+	AllowTimestamp bool
+	// RequireMidnight rejects timestamps whose time of day, after applying
+	// Zone, is not 00:00:00.
+	RequireMidnight bool
+	Zone            ZonePolicy
+	// Location is used with ZoneLocation. A nil Location means UTC.
+	Location *time.Location
+}
+
+var (
+	Strict  = Parser{}
+	Lenient = Parser{TrimSpace: true, AllowCompact: true, AllowUnpadded: true, AllowTimestamp: true}
+)
+
+// JSONParser is used by the UnmarshalJSON and UnmarshalText methods of Date
+// and NullDate. It applies to the whole process and is not safe to change
+// while decoding runs, so set it once at start-up; Parser.Unmarshaler and
+// LenientDate choose a parser for a single value instead.
+var JSONParser = Strict
+
+// Unmarshaler decodes JSON strings and text into a Date.
+type Unmarshaler interface {
+	json.Unmarshaler
+	encoding.TextUnmarshaler
+}
+
+// Unmarshaler returns an Unmarshaler that decodes into d with p instead of
+// JSONParser:
+//
+//	err := json.Unmarshal(data, date.Lenient.Unmarshaler(&d))
+func (p Parser) Unmarshaler(d *Date) Unmarshaler {
+	return &dateUnmarshaler{d, p}
+}
+
+type dateUnmarshaler struct {
+	d *Date
+	p Parser
+}
+
+func (u *dateUnmarshaler) UnmarshalJSON(data []byte) error {
+	d, err := u.p.parseJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*u.d = d
+	return nil
+}
+
+func (u *dateUnmarshaler) UnmarshalText(data []byte) error {
+	d, err := u.p.parseText(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u.d = d
+	return nil
+}
+
+// LenientDate is a Date that JSON and text decoding read with Lenient,
+// whatever JSONParser is, for struct fields that accept loose input.
+type LenientDate struct {
+	Date
+}
+
+func (d *LenientDate) UnmarshalJSON(data []byte) error {
+	return Lenient.Unmarshaler(&d.Date).UnmarshalJSON(data)
+}
+
+func (d *LenientDate) UnmarshalText(data []byte) error {
+	return Lenient.Unmarshaler(&d.Date).UnmarshalText(data)
+}
+
+// parseJSON parses a JSON string with p, accepting the infinities.
+func (p Parser) parseJSON(data []byte) (Date, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Date{}, err
+	}
+
+	return p.parseText(s)
+}
+
+// parseText also reads the infinities and the "0000-00-00" that MarshalText
+// writes for the zero Date.
+func (p Parser) parseText(s string) (Date, error) {
+	if d, ok := parseInfinity(s); ok {
+		return d, nil
+	}
+	if s == "0000-00-00" {
+		return Date{}, nil
+	}
+
+	return p.Parse(s)
+}
+
+func (p Parser) Parse(value string) (Date, error) {
+	s := value
+	if p.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+
+	d, rest, err := p.parseDate(s)
+	if err != nil {
+		return Date{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	if rest == "" {
+		return d, nil
+	}
+
+	if !p.AllowTimestamp || (rest[0] != 'T' && rest[0] != 't' && rest[0] != ' ') {
+		return Date{}, &ParseError{Value: value, Message: fmt.Sprintf("extra text %q", rest)}
+	}
+
+	t, hasOffset, err := parseTimestamp(d, rest[1:])
+	if err != nil {
+		return Date{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	if hasOffset {
+		t = p.Zone.time(t, p.Location)
+	}
+
+	if p.RequireMidnight && (t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0) {
+		return Date{}, &ParseError{Value: value, Message: "time of day is not midnight"}
+	}
+
+	return FromTime(t), nil
+}
+
+func (p Parser) parseDate(s string) (Date, string, error) {
+	if p.AllowCompact && len(s) >= 8 && allDigits(s[:8]) && (len(s) == 8 || !isDigit(s[8])) {
+		year, month, day := atoi(s[:4]), atoi(s[4:6]), atoi(s[6:8])
+		d, err := New(year, time.Month(month), day)
+		return d, s[8:], err
+	}
+
+	minWidth := 2
+	if p.AllowUnpadded {
+		minWidth = 1
+	}
+
+	year, rest, err := getDigits(s, 4, 4)
+	if err != nil {
+		return Date{}, s, err
+	}
+
+	month, rest, err := getSeparatedDigits(rest, '-', minWidth)
+	if err != nil {
+		return Date{}, s, err
+	}
+
+	day, rest, err := getSeparatedDigits(rest, '-', minWidth)
+	if err != nil {
+		return Date{}, s, err
+	}
+
+	d, err := New(year, time.Month(month), day)
+	return d, rest, err
+}
+
+func getSeparatedDigits(s string, sep byte, minWidth int) (int, string, error) {
+	if s == "" || s[0] != sep {
+		return 0, s, fmt.Errorf("expected %q at %q", sep, s)
+	}
+
+	return getDigits(s[1:], minWidth, 2)
+}
+
+// parseTimestamp reads "HH:MM[:SS[.fraction]][zone]" where zone is "Z" or an
+// offset of the form ±HH:MM, ±HHMM or ±HH. Without a zone, the time is
+// returned in UTC and hasOffset is false.
+func parseTimestamp(d Date, s string) (t time.Time, hasOffset bool, err error) {
+	hour, rest, err := getDigits(s, 2, 2)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	minute, rest, err := getSeparatedDigits(rest, ':', 2)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	var second, nanos int
+	if rest != "" && rest[0] == ':' {
+		if second, rest, err = getSeparatedDigits(rest, ':', 2); err != nil {
+			return time.Time{}, false, err
+		}
+
+		if rest != "" && (rest[0] == '.' || rest[0] == ',') {
+			n := 1
+			for n < len(rest) && isDigit(rest[n]) {
+				n++
+			}
+			if n == 1 {
+				return time.Time{}, false, fmt.Errorf("expected fraction at %q", rest)
+			}
+
+			frac := rest[1:n]
+			if len(frac) > 9 {
+				frac = frac[:9]
+			}
+			nanos = atoi(frac + strings.Repeat("0", 9-len(frac)))
+			rest = rest[n:]
+		}
+	}
+
+	if hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, false, fmt.Errorf("time %02d:%02d:%02d out of range", hour, minute, second)
+	}
+
+	loc := time.UTC
+	switch {
+	case rest == "":
+		return time.Date(d.Year, d.Month, d.Day, hour, minute, second, nanos, loc), false, nil
+	case rest == "Z" || rest == "z":
+		// UTC
+	case rest[0] == '+' || rest[0] == '-':
+		offset, err := parseOffset(rest)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		loc = time.FixedZone("", offset)
+	default:
+		return time.Time{}, false, fmt.Errorf("extra text %q", rest)
+	}
+
+	return time.Date(d.Year, d.Month, d.Day, hour, minute, second, nanos, loc), true, nil
+}
+
+// parseOffset parses a UTC offset of the form ±HH:MM, ±HHMM or ±HH and
+// returns it in seconds east of UTC.
+func parseOffset(s string) (int, error) {
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+
+	hours, rest, err := getDigits(s[1:], 2, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	var minutes int
+	if rest != "" {
+		if rest[0] == ':' {
+			rest = rest[1:]
+		}
+		if minutes, rest, err = getDigits(rest, 2, 2); err != nil {
+			return 0, err
+		}
+	}
+
+	if rest != "" {
+		return 0, fmt.Errorf("extra text %q", rest)
+	}
+
+	if hours > 23 || minutes > 59 {
+		return 0, fmt.Errorf("offset %q out of range", s)
+	}
+
+	return sign * (hours*3600 + minutes*60), nil
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+
+	return n
+}
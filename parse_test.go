@@ -0,0 +1,168 @@
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParser_Parse(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		parser Parser
+		value  string
+		want   Date
+	}{
+		{"strict", Strict, "2026-10-17", Date{2026, 10, 17}},
+		{"trim", Parser{TrimSpace: true}, " 2026-10-17\n", Date{2026, 10, 17}},
+		{"compact", Parser{AllowCompact: true}, "20261017", Date{2026, 10, 17}},
+		{"compact allows extended", Parser{AllowCompact: true}, "2026-10-17", Date{2026, 10, 17}},
+		{"unpadded", Parser{AllowUnpadded: true}, "2026-1-5", Date{2026, 1, 5}},
+		{"unpadded allows padded", Parser{AllowUnpadded: true}, "2026-01-05", Date{2026, 1, 5}},
+		{"timestamp UTC", Parser{AllowTimestamp: true}, "2026-10-17T00:00:00Z", Date{2026, 10, 17}},
+		{"timestamp without offset", Parser{AllowTimestamp: true, Zone: ZoneUTC}, "2026-10-17 23:30:00", Date{2026, 10, 17}},
+		{"timestamp fraction", Parser{AllowTimestamp: true}, "2026-10-17T12:00:00.123456789123Z", Date{2026, 10, 17}},
+		{"timestamp minutes only", Parser{AllowTimestamp: true}, "2026-10-17T12:00", Date{2026, 10, 17}},
+		{"wall date", Parser{AllowTimestamp: true, Zone: ZoneWall}, "2026-10-17T23:30:00-05:00", Date{2026, 10, 17}},
+		{"UTC date", Parser{AllowTimestamp: true, Zone: ZoneUTC}, "2026-10-17T23:30:00-05:00", Date{2026, 10, 18}},
+		{"UTC date, positive offset", Parser{AllowTimestamp: true, Zone: ZoneUTC}, "2026-10-17T00:30:00+0200", Date{2026, 10, 16}},
+		{"location date", Parser{AllowTimestamp: true, Zone: ZoneLocation, Location: newYork}, "2026-10-17T02:00:00Z", Date{2026, 10, 16}},
+		{"location defaults to UTC", Parser{AllowTimestamp: true, Zone: ZoneLocation}, "2026-10-17T02:00:00+03", Date{2026, 10, 16}},
+		{"midnight", Parser{AllowTimestamp: true, RequireMidnight: true}, "2026-10-17T00:00:00Z", Date{2026, 10, 17}},
+		{"midnight after conversion", Parser{AllowTimestamp: true, RequireMidnight: true, Zone: ZoneUTC}, "2026-10-17T02:00:00+02:00", Date{2026, 10, 17}},
+		{"lenient", Lenient, " 2026-1-5T10:00:00Z ", Date{2026, 1, 5}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.parser.Parse(c.value)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", c.value, err)
+			}
+
+			if !got.Equal(c.want) {
+				t.Errorf("Parse(%q) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParser_Parse_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		parser Parser
+		value  string
+	}{
+		{"strict spaces", Strict, " 2026-10-17 "},
+		{"strict timestamp", Strict, "2026-10-17T00:00:00Z"},
+		{"strict compact", Strict, "20261017"},
+		{"strict unpadded", Strict, "2026-1-5"},
+		{"invalid date", Lenient, "2026-02-30"},
+		{"invalid compact date", Lenient, "20261301"},
+		{"compact too long", Lenient, "202610170"},
+		{"short year", Lenient, "26-10-17"},
+		{"hour out of range", Lenient, "2026-10-17T24:00:00Z"},
+		{"bad offset", Lenient, "2026-10-17T00:00:00+2"},
+		{"bad zone", Lenient, "2026-10-17T00:00:00 UTC"},
+		{"bad separator", Lenient, "2026-10-17X00:00:00Z"},
+		{"not midnight", Parser{AllowTimestamp: true, RequireMidnight: true}, "2026-10-17T00:00:01Z"},
+		{"not midnight after conversion", Parser{AllowTimestamp: true, RequireMidnight: true, Zone: ZoneUTC}, "2026-10-17T00:00:00+02:00"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d, err := c.parser.Parse(c.value)
+			if err == nil {
+				t.Fatalf("Parse(%q) = %v, <nil>; want error", c.value, d)
+			}
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("Parse(%q) error = %v; want *ParseError", c.value, err)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestJSONParser(t *testing.T) {
+	defer func(p Parser) { JSONParser = p }(JSONParser)
+
+	var d Date
+	if err := json.Unmarshal([]byte(`"2026-10-17T00:00:00Z"`), &d); err == nil {
+		t.Errorf("json.Unmarshal() with strict JSONParser = <nil>; want error")
+	}
+
+	JSONParser = Lenient
+
+	var s struct {
+		Date     Date
+		NullDate NullDate
+	}
+	if err := json.Unmarshal([]byte(`{"Date": " 2026-10-17T00:00:00Z", "NullDate": "20261018"}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() with lenient JSONParser: %v", err)
+	}
+
+	if !s.Date.Equal(Date{2026, 10, 17}) {
+		t.Errorf("Date = %v; want 2026-10-17", s.Date)
+	}
+	if !s.NullDate.Valid || !s.NullDate.Date.Equal(Date{2026, 10, 18}) {
+		t.Errorf("NullDate = %v; want 2026-10-18", s.NullDate)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParser_Unmarshaler(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`" 2026-10-17T00:00:00Z"`), Lenient.Unmarshaler(&d)); err != nil || d != (Date{2026, 10, 17}) {
+		t.Errorf("json.Unmarshal(Lenient.Unmarshaler) = %v, %v; want 2026-10-17", d, err)
+	}
+
+	if err := Lenient.Unmarshaler(&d).UnmarshalText([]byte("20261018")); err != nil || d != (Date{2026, 10, 18}) {
+		t.Errorf("UnmarshalText(Lenient.Unmarshaler) = %v, %v; want 2026-10-18", d, err)
+	}
+
+	if err := Lenient.Unmarshaler(&d).UnmarshalText([]byte("-infinity")); err != nil || d != NegInfinity {
+		t.Errorf("UnmarshalText(-infinity) = %v, %v; want -infinity", d, err)
+	}
+
+	if err := json.Unmarshal([]byte(`"20261018"`), Strict.Unmarshaler(&d)); err == nil {
+		t.Error("json.Unmarshal(Strict.Unmarshaler, 20261018) = <nil>; want error")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestLenientDate(t *testing.T) {
+	var s struct {
+		Loose  LenientDate
+		Strict Date
+		Null   Null[LenientDate]
+	}
+
+	err := json.Unmarshal([]byte(`{"Loose": "2026-1-5", "Strict": "2026-10-17", "Null": "20261018"}`), &s)
+	if err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+
+	if s.Loose.Date != (Date{2026, 1, 5}) || s.Strict != (Date{2026, 10, 17}) || s.Null != NullFrom(LenientDate{Date{2026, 10, 18}}) {
+		t.Errorf("json.Unmarshal() = %+v", s)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Strict": "2026-1-5"}`), &s); err == nil {
+		t.Error("json.Unmarshal() into Date with strict JSONParser = <nil>; want error")
+	}
+
+	data, err := json.Marshal(s.Loose)
+	if err != nil || string(data) != `"2026-01-05"` {
+		t.Errorf("json.Marshal(LenientDate) = %s, %v; want \"2026-01-05\"", data, err)
+	}
+}
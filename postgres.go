@@ -0,0 +1,285 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// PGDateRange is a PostgreSQL daterange. A bound that is not Valid is
+// unbounded. Like PostgreSQL, Scan and ParsePGDateRange return the canonical
+// form, which includes its lower bound and excludes its upper bound:
+// "[2026-01-01,2026-01-31]" becomes "[2026-01-01,2026-02-01)".
+type PGDateRange struct {
+	Lower          NullDate
+	Upper          NullDate
+	LowerInclusive bool
+	UpperInclusive bool
+	// Empty is the range containing no dates; its bounds are ignored.
+	Empty bool
+}
+
+// PGDateRangeFrom returns the canonical daterange covering r.
+func PGDateRangeFrom(r Range) PGDateRange {
+	return PGDateRange{
+		Lower:          NullDateFrom(r.Start),
+		Upper:          NullDateFrom(r.End),
+		LowerInclusive: true,
+		UpperInclusive: true,
+	}.Canonical()
+}
+
+// ParsePGDateRange parses the PostgreSQL text format of a daterange, such as
+// "[2026-01-01,2026-02-01)", "(,2026-02-01)" or "empty".
+func ParsePGDateRange(value string) (PGDateRange, error) {
+	s := strings.TrimSpace(value)
+	if strings.EqualFold(s, "empty") {
+		return PGDateRange{Empty: true}, nil
+	}
+
+	if len(s) < 3 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return PGDateRange{}, &ParseError{Value: value, Message: "expected \"empty\" or bounds in [] or ()"}
+	}
+
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok || strings.Contains(upper, ",") {
+		return PGDateRange{}, &ParseError{Value: value, Message: "expected two bounds separated by a comma"}
+	}
+
+	r := PGDateRange{LowerInclusive: s[0] == '[', UpperInclusive: s[len(s)-1] == ']'}
+
+	var err error
+	if r.Lower, err = parsePGElement(lower); err != nil {
+		return PGDateRange{}, &ParseError{Value: value, Message: err.Error()}
+	}
+	if r.Upper, err = parsePGElement(upper); err != nil {
+		return PGDateRange{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	if r.Lower.Valid && r.Upper.Valid && r.Lower.Date.IsAfter(r.Upper.Date) {
+		return PGDateRange{}, &ParseError{Value: value, Message: "lower bound must be less than or equal to upper bound"}
+	}
+
+	return r.Canonical(), nil
+}
+
+// Canonical returns r in PostgreSQL's canonical form: a bounded lower bound is
+// inclusive, a bounded upper bound is exclusive, unbounded sides are
+// exclusive, and a range without dates is Empty.
+func (r PGDateRange) Canonical() PGDateRange {
+	if r.Empty {
+		return PGDateRange{Empty: true}
+	}
+
+	if r.Lower.Valid && !r.LowerInclusive {
+		r.Lower.Date = r.Lower.Date.AddDays(1)
+	}
+	r.LowerInclusive = r.Lower.Valid
+
+	if r.Upper.Valid && r.UpperInclusive {
+		r.Upper.Date = r.Upper.Date.AddDays(1)
+	}
+	r.UpperInclusive = false
+
+	if !r.Lower.Valid {
+		r.Lower.Date = Date{}
+	}
+	if !r.Upper.Valid {
+		r.Upper.Date = Date{}
+	}
+
+	if r.Lower.Valid && r.Upper.Valid && !r.Lower.Date.IsBefore(r.Upper.Date) {
+		return PGDateRange{Empty: true}
+	}
+
+	return r
+}
+
+// Range returns the dates in r as an inclusive Range, using NegInfinity and
+// Infinity for unbounded sides. It returns false for an empty range.
+func (r PGDateRange) Range() (Range, bool) {
+	r = r.Canonical()
+	if r.Empty {
+		return Range{}, false
+	}
+
+	result := Range{NegInfinity, Infinity}
+	if r.Lower.Valid {
+		result.Start = r.Lower.Date
+	}
+	if r.Upper.Valid {
+		result.End = r.Upper.Date
+		if !r.Upper.Date.IsInfinite() {
+			result.End = r.Upper.Date.AddDays(-1)
+		}
+	}
+
+	return result, true
+}
+
+func (r PGDateRange) Contains(d Date) bool {
+	dates, ok := r.Range()
+	return ok && dates.Contains(d)
+}
+
+// String returns r in the PostgreSQL text format, without canonicalizing it.
+func (r PGDateRange) String() string {
+	if r.Empty {
+		return "empty"
+	}
+
+	var b strings.Builder
+	if r.LowerInclusive && r.Lower.Valid {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if r.Lower.Valid {
+		b.WriteString(r.Lower.Date.String())
+	}
+	b.WriteByte(',')
+	if r.Upper.Valid {
+		b.WriteString(r.Upper.Date.String())
+	}
+	if r.UpperInclusive && r.Upper.Valid {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+
+	return b.String()
+}
+
+// Value returns the canonical text form of r.
+func (r PGDateRange) Value() (driver.Value, error) {
+	return r.Canonical().String(), nil
+}
+
+func (r *PGDateRange) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into PGDateRange", value)
+	}
+
+	parsed, err := ParsePGDateRange(s)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// PGDateArray is a PostgreSQL date[] of one dimension without NULL elements.
+// A nil PGDateArray is stored as NULL.
+type PGDateArray []Date
+
+// ParsePGDateArray parses the PostgreSQL text format of a date[], such as
+// "{2026-01-01,2026-01-02}".
+func ParsePGDateArray(value string) (PGDateArray, error) {
+	s := strings.TrimSpace(value)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, &ParseError{Value: value, Message: "expected elements in {}"}
+	}
+
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if s == "" {
+		return PGDateArray{}, nil
+	}
+
+	elements := strings.Split(s, ",")
+	a := make(PGDateArray, len(elements))
+	for i, e := range elements {
+		e = strings.TrimSpace(e)
+		if strings.EqualFold(e, "null") {
+			return nil, &ParseError{Value: value, Message: fmt.Sprintf("element %d is NULL", i+1)}
+		}
+
+		d, err := parsePGElement(e)
+		if err != nil {
+			return nil, &ParseError{Value: value, Message: err.Error()}
+		}
+		if !d.Valid {
+			return nil, &ParseError{Value: value, Message: fmt.Sprintf("element %d is empty", i+1)}
+		}
+
+		a[i] = d.Date
+	}
+
+	return a, nil
+}
+
+func (a PGDateArray) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, d := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(d.String())
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+func (a PGDateArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	return a.String(), nil
+}
+
+func (a *PGDateArray) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into PGDateArray", value)
+	}
+
+	parsed, err := ParsePGDateArray(s)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// parsePGElement parses a range bound or array element: a date, "infinity"
+// or "-infinity", optionally in double quotes. An empty string gives a null
+// date.
+func parsePGElement(s string) (NullDate, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return NullDate{}, nil
+	}
+
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	if d, ok := parseInfinity(s); ok {
+		return NullDateFrom(d), nil
+	}
+
+	d, err := Strict.Parse(s)
+	if err != nil {
+		return NullDate{}, err
+	}
+
+	return NullDateFrom(d), nil
+}
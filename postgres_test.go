@@ -0,0 +1,222 @@
+package date_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+func TestParsePGDateRange(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{"[2026-01-01,2026-02-01)", "[2026-01-01,2026-02-01)"},
+		{"[2026-01-01,2026-01-31]", "[2026-01-01,2026-02-01)"},
+		{"(2025-12-31,2026-02-01)", "[2026-01-01,2026-02-01)"},
+		{"(2025-12-31,2026-01-31]", "[2026-01-01,2026-02-01)"},
+		{"[2026-01-01,2026-01-01]", "[2026-01-01,2026-01-02)"},
+		{"[2026-01-01,2026-01-01)", "empty"},
+		{"(2026-01-01,2026-01-02)", "empty"},
+		{"EMPTY", "empty"},
+		{"(,2026-02-01)", "(,2026-02-01)"},
+		{"[,2026-01-31]", "(,2026-02-01)"},
+		{"[2026-01-01,)", "[2026-01-01,)"},
+		{"[2026-01-01,]", "[2026-01-01,)"},
+		{"(,)", "(,)"},
+		{"[-infinity,infinity]", "[-infinity,infinity)"},
+		{"[2026-01-01,infinity)", "[2026-01-01,infinity)"},
+		{` [ "2026-01-01" , "2026-02-01" ) `, "[2026-01-01,2026-02-01)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParsePGDateRange(c.value)
+			if err != nil {
+				t.Fatalf("ParsePGDateRange(%q): %v", c.value, err)
+			}
+
+			if got.String() != c.want {
+				t.Errorf("ParsePGDateRange(%q) = %v; want %v", c.value, got, c.want)
+			}
+
+			var scanned PGDateRange
+			if err := scanned.Scan([]byte(c.value)); err != nil {
+				t.Fatalf("Scan(%q): %v", c.value, err)
+			}
+
+			v, err := scanned.Value()
+			if err != nil {
+				t.Fatalf("Value(): %v", err)
+			}
+
+			if v != c.want {
+				t.Errorf("Value() = %v; want %v", v, c.want)
+			}
+		})
+	}
+}
+
+func TestParsePGDateRange_Errors(t *testing.T) {
+	cases := []string{
+		"",
+		"[]",
+		"2026-01-01,2026-02-01",
+		"[2026-01-01)",
+		"[2026-01-01,2026-02-01,2026-03-01)",
+		"[2026-02-01,2026-01-01)",
+		"[2026-01-01,2026-02-30)",
+		"[null,2026-01-01)",
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			if got, err := ParsePGDateRange(c); err == nil {
+				t.Errorf("ParsePGDateRange(%q) = %v, <nil>; want error", c, got)
+			}
+		})
+	}
+
+	var r PGDateRange
+	if err := r.Scan(nil); err == nil {
+		t.Error("Scan(nil) = <nil>; want error")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPGDateRange_Range(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Range
+		ok    bool
+	}{
+		{"[2026-01-01,2026-02-01)", Range{Date{2026, 1, 1}, Date{2026, 1, 31}}, true},
+		{"(,2026-02-01)", Range{NegInfinity, Date{2026, 1, 31}}, true},
+		{"[2026-01-01,)", Range{Date{2026, 1, 1}, Infinity}, true},
+		{"[2026-01-01,infinity)", Range{Date{2026, 1, 1}, Infinity}, true},
+		{"empty", Range{}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			r, err := ParsePGDateRange(c.value)
+			if err != nil {
+				t.Fatalf("ParsePGDateRange(%q): %v", c.value, err)
+			}
+
+			got, ok := r.Range()
+			if got != c.want || ok != c.ok {
+				t.Errorf("%v.Range() = %v, %t; want %v, %t", r, got, ok, c.want, c.ok)
+			}
+
+			// Unbounded sides come back as infinity bounds, which cover the
+			// same dates.
+			if back, _ := PGDateRangeFrom(got).Range(); ok && back != got {
+				t.Errorf("PGDateRangeFrom(%v).Range() = %v; want %v", got, back, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPGDateRange_Contains(t *testing.T) {
+	r := PGDateRange{
+		Lower:          NullDateFrom(Date{2026, 1, 1}),
+		Upper:          NullDateFrom(Date{2026, 2, 1}),
+		LowerInclusive: true,
+	}
+
+	cases := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2025, 12, 31}, false},
+		{Date{2026, 1, 1}, true},
+		{Date{2026, 1, 31}, true},
+		{Date{2026, 2, 1}, false},
+	}
+
+	for _, c := range cases {
+		if got := r.Contains(c.date); got != c.want {
+			t.Errorf("%v.Contains(%v) = %t; want %t", r, c.date, got, c.want)
+		}
+	}
+
+	if (PGDateRange{Empty: true}).Contains(Date{2026, 1, 1}) {
+		t.Error("empty range should contain no dates")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParsePGDateArray(t *testing.T) {
+	cases := []struct {
+		value string
+		want  PGDateArray
+	}{
+		{"{}", PGDateArray{}},
+		{"{2026-01-01}", PGDateArray{{2026, 1, 1}}},
+		{"{2026-01-01,2026-01-02}", PGDateArray{{2026, 1, 1}, {2026, 1, 2}}},
+		{`{ "2026-01-01" , infinity,-infinity }`, PGDateArray{{2026, 1, 1}, Infinity, NegInfinity}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			var got PGDateArray
+			if err := got.Scan(c.value); err != nil {
+				t.Fatalf("Scan(%q): %v", c.value, err)
+			}
+
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Scan(%q) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParsePGDateArray_Errors(t *testing.T) {
+	cases := []string{
+		"",
+		"2026-01-01",
+		"{2026-01-01,NULL}",
+		"{2026-01-01,}",
+		"{{2026-01-01}}",
+		"{2026-02-30}",
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			if got, err := ParsePGDateArray(c); err == nil {
+				t.Errorf("ParsePGDateArray(%q) = %v, <nil>; want error", c, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestPGDateArray_Value(t *testing.T) {
+	cases := []struct {
+		array PGDateArray
+		want  any
+	}{
+		{nil, nil},
+		{PGDateArray{}, "{}"},
+		{PGDateArray{{2026, 1, 1}, Infinity}, "{2026-01-01,infinity}"},
+	}
+
+	for _, c := range cases {
+		got, err := c.array.Value()
+		if err != nil {
+			t.Fatalf("Value(): %v", err)
+		}
+
+		if got != c.want {
+			t.Errorf("%#v.Value() = %#v; want %#v", c.array, got, c.want)
+		}
+	}
+
+	a := PGDateArray{{2026, 1, 1}}
+	if err := a.Scan(nil); err != nil || a != nil {
+		t.Errorf("Scan(nil) = %v, %v; want nil, <nil>", a, err)
+	}
+}
@@ -0,0 +1,41 @@
+package date
+
+import "math"
+
+// Range is a span of dates including both Start and End.
+type Range struct {
+	Start Date
+	End   Date
+}
+
+func SingleDay(d Date) Range {
+	return Range{d, d}
+}
+
+// Single reports whether the range covers exactly one day, and returns it.
+func (r Range) Single() (Date, bool) {
+	return r.Start, r.Start.Equal(r.End)
+}
+
+func (r Range) Contains(d Date) bool {
+	return !d.IsBefore(r.Start) && !d.IsAfter(r.End)
+}
+
+// Days returns the number of days in the range, 0 if End is before Start, or
+// math.MaxInt if the range is unbounded.
+func (r Range) Days() int {
+	if r.End.IsBefore(r.Start) {
+		return 0
+	}
+
+	if r.Start.IsInfinite() || r.End.IsInfinite() {
+		return math.MaxInt
+	}
+
+	return DiffInDays(r.Start, r.End) + 1
+}
+
+// String returns the range as an ISO 8601 interval, "2026-10-01/2026-10-31".
+func (r Range) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
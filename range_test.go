@@ -0,0 +1,88 @@
+package date_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRange_Contains(t *testing.T) {
+	r := Range{Date{2026, 10, 1}, Date{2026, 10, 31}}
+	cases := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2026, 9, 30}, false},
+		{Date{2026, 10, 1}, true},
+		{Date{2026, 10, 17}, true},
+		{Date{2026, 10, 31}, true},
+		{Date{2026, 11, 1}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.date.String(), func(t *testing.T) {
+			if got := r.Contains(c.date); got != c.want {
+				t.Errorf("%v.Contains(%v) = %v; want %v", r, c.date, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRange_Days(t *testing.T) {
+	cases := []struct {
+		r    Range
+		want int
+	}{
+		{SingleDay(Date{2026, 10, 17}), 1},
+		{Range{Date{2026, 10, 1}, Date{2026, 10, 31}}, 31},
+		{Range{Date{2024, 1, 1}, Date{2024, 12, 31}}, 366},
+		{Range{Date{2026, 10, 2}, Date{2026, 10, 1}}, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.r.String(), func(t *testing.T) {
+			if got := c.r.Days(); got != c.want {
+				t.Errorf("%v.Days() = %d; want %d", c.r, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRange_Single(t *testing.T) {
+	if d, ok := SingleDay(Date{2026, 10, 17}).Single(); !ok || !d.Equal(Date{2026, 10, 17}) {
+		t.Errorf("SingleDay(2026-10-17).Single() = %v, %v; want 2026-10-17, true", d, ok)
+	}
+
+	if _, ok := (Range{Date{2026, 10, 17}, Date{2026, 10, 18}}).Single(); ok {
+		t.Errorf("Range{2026-10-17, 2026-10-18}.Single() = _, true; want false")
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRange_Infinite(t *testing.T) {
+	validFrom := Range{Date{2026, 1, 1}, Infinity}
+	if !validFrom.Contains(Date{9999, 12, 31}) || validFrom.Contains(Date{2025, 12, 31}) {
+		t.Errorf("%v.Contains() reports wrong values", validFrom)
+	}
+
+	if !validFrom.Contains(Infinity) {
+		t.Errorf("%v should contain infinity", validFrom)
+	}
+
+	if days := validFrom.Days(); days != math.MaxInt {
+		t.Errorf("%v.Days() = %d; want math.MaxInt", validFrom, days)
+	}
+
+	always := Range{NegInfinity, Infinity}
+	if s := always.String(); s != "-infinity/infinity" {
+		t.Errorf("String() = %q; want \"-infinity/infinity\"", s)
+	}
+
+	if days := (Range{Infinity, NegInfinity}).Days(); days != 0 {
+		t.Errorf("Days() of an empty range = %d; want 0", days)
+	}
+}
@@ -0,0 +1,359 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unit is a calendar unit used by relative expressions.
+type Unit int
+
+const (
+	UnitDay Unit = iota
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitYear
+	UnitBusinessDay
+)
+
+func (u Unit) String() string {
+	switch u {
+	case UnitDay:
+		return "day"
+	case UnitWeek:
+		return "week"
+	case UnitMonth:
+		return "month"
+	case UnitQuarter:
+		return "quarter"
+	case UnitYear:
+		return "year"
+	case UnitBusinessDay:
+		return "business day"
+	}
+
+	return fmt.Sprintf("Unit(%d)", int(u))
+}
+
+// shift moves d by n units. Months, quarters and years are clamped to the end
+// of the month, so one month after January 31 is the last day of February.
+func (u Unit) shift(d Date, n int) Date {
+	switch u {
+	case UnitWeek:
+		return d.AddDays(7 * n)
+	case UnitMonth:
+		return d.addMonthsClamped(n)
+	case UnitQuarter:
+		return d.addMonthsClamped(3 * n)
+	case UnitYear:
+		return d.addMonthsClamped(12 * n)
+	case UnitBusinessDay:
+		return d.AddBusinessDays(n)
+	}
+
+	return d.AddDays(n)
+}
+
+// period returns the unit-long calendar period containing d. Weeks run from
+// Monday to Sunday.
+func (u Unit) period(d Date) (Range, bool) {
+	switch u {
+	case UnitDay:
+		return SingleDay(d), true
+	case UnitWeek:
+		return Range{d.FirstOfWeek(), d.LastOfWeek()}, true
+	case UnitMonth:
+		return Range{d.FirstOfMonth(), d.LastOfMonth()}, true
+	case UnitQuarter:
+		return Range{d.FirstOfQuarter(), d.LastOfQuarter()}, true
+	case UnitYear:
+		return Range{d.FirstOfYear(), d.LastOfYear()}, true
+	}
+
+	return Range{}, false
+}
+
+// Vocabulary holds the words a RelativeParser understands. Words are written
+// in lower case, match input in any case and may contain single spaces, as in
+// "business days".
+type Vocabulary struct {
+	Today     []string
+	Yesterday []string
+	Tomorrow  []string
+	// Next, Last and This precede a weekday or a unit: "next Friday",
+	// "last quarter", "this week".
+	Next []string
+	Last []string
+	This []string
+	// In precedes an amount in the future: "in 3 days". Ago follows or
+	// precedes an amount in the past: "3 days ago", "vor 3 Tagen".
+	In  []string
+	Ago []string
+	// StartOf and EndOf precede a period: "end of month", "start of next
+	// year".
+	StartOf []string
+	EndOf   []string
+	// Numbers maps number words such as "a" or "two" to their value.
+	Numbers  map[string]int
+	Units    map[string]Unit
+	Weekdays map[string]time.Weekday
+}
+
+var EnglishVocabulary = &Vocabulary{
+	Today:     []string{"today", "now"},
+	Yesterday: []string{"yesterday"},
+	Tomorrow:  []string{"tomorrow"},
+	Next:      []string{"next", "coming"},
+	Last:      []string{"last", "previous", "past"},
+	This:      []string{"this", "current", "the current"},
+	In:        []string{"in"},
+	Ago:       []string{"ago"},
+	StartOf:   []string{"start of", "start of the", "beginning of", "beginning of the"},
+	EndOf:     []string{"end of", "end of the"},
+	Numbers: map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	},
+	Units: map[string]Unit{
+		"day": UnitDay, "days": UnitDay,
+		"week": UnitWeek, "weeks": UnitWeek,
+		"month": UnitMonth, "months": UnitMonth,
+		"quarter": UnitQuarter, "quarters": UnitQuarter,
+		"year": UnitYear, "years": UnitYear,
+		"business day": UnitBusinessDay, "business days": UnitBusinessDay,
+		"working day": UnitBusinessDay, "working days": UnitBusinessDay,
+		"weekday": UnitBusinessDay, "weekdays": UnitBusinessDay,
+	},
+	Weekdays: map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	},
+}
+
+var GermanVocabulary = &Vocabulary{
+	Today:     []string{"heute"},
+	Yesterday: []string{"gestern"},
+	Tomorrow:  []string{"morgen"},
+	Next:      []string{"nächste", "nächsten", "nächster", "nächstes", "kommende", "kommenden", "kommender"},
+	Last:      []string{"letzte", "letzten", "letzter", "letztes", "vorige", "vorigen", "voriger"},
+	This:      []string{"diese", "diesen", "dieser", "dieses"},
+	In:        []string{"in"},
+	Ago:       []string{"vor"},
+	StartOf:   []string{"anfang", "anfang des", "anfang der", "beginn des", "beginn der"},
+	EndOf:     []string{"ende", "ende des", "ende der"},
+	Numbers: map[string]int{
+		"ein": 1, "eine": 1, "einem": 1, "einer": 1, "zwei": 2, "drei": 3, "vier": 4, "fünf": 5,
+		"sechs": 6, "sieben": 7, "acht": 8, "neun": 9, "zehn": 10, "elf": 11, "zwölf": 12,
+	},
+	Units: map[string]Unit{
+		"tag": UnitDay, "tage": UnitDay, "tagen": UnitDay, "tages": UnitDay,
+		"woche": UnitWeek, "wochen": UnitWeek,
+		"monat": UnitMonth, "monate": UnitMonth, "monaten": UnitMonth, "monats": UnitMonth,
+		"quartal": UnitQuarter, "quartale": UnitQuarter, "quartalen": UnitQuarter, "quartals": UnitQuarter,
+		"jahr": UnitYear, "jahre": UnitYear, "jahren": UnitYear, "jahres": UnitYear,
+		"werktag": UnitBusinessDay, "werktage": UnitBusinessDay, "werktagen": UnitBusinessDay,
+		"arbeitstag": UnitBusinessDay, "arbeitstage": UnitBusinessDay, "arbeitstagen": UnitBusinessDay,
+	},
+	Weekdays: map[string]time.Weekday{
+		"sonntag": time.Sunday, "montag": time.Monday, "dienstag": time.Tuesday, "mittwoch": time.Wednesday,
+		"donnerstag": time.Thursday, "freitag": time.Friday, "samstag": time.Saturday, "sonnabend": time.Saturday,
+	},
+}
+
+// RelativeParser resolves relative date expressions against a reference date.
+// It understands:
+//
+//   - today, yesterday, tomorrow
+//   - next/last/this followed by a weekday: "next Friday" is the first Friday
+//     after the reference date, "last Friday" the last one before it, and
+//     "this Friday" the Friday of the reference date's week (Monday to Sunday)
+//   - next/last/this followed by a unit: "last quarter" is the whole previous
+//     calendar quarter
+//   - in/ago with an amount: "in 3 business days", "2 weeks ago"
+//   - start/end of a period: "end of month", "start of next year"
+//
+// Periods resolve to a Range; everything else to a single-day Range.
+type RelativeParser struct {
+	// Vocabulary defaults to EnglishVocabulary.
+	Vocabulary *Vocabulary
+}
+
+func ParseRelative(value string, ref Date) (Range, error) {
+	return RelativeParser{}.Parse(value, ref)
+}
+
+func (p RelativeParser) Parse(value string, ref Date) (Range, error) {
+	v := p.Vocabulary
+	if v == nil {
+		v = EnglishVocabulary
+	}
+
+	s := strings.Join(strings.Fields(strings.ToLower(value)), " ")
+
+	if r, ok := v.parse(s, ref); ok {
+		return r, nil
+	}
+
+	return Range{}, &ParseError{Value: value, Message: "unknown relative date expression"}
+}
+
+func (v *Vocabulary) parse(s string, ref Date) (Range, bool) {
+	switch {
+	case matchPhrase(s, v.Today):
+		return SingleDay(ref), true
+	case matchPhrase(s, v.Yesterday):
+		return SingleDay(ref.AddDays(-1)), true
+	case matchPhrase(s, v.Tomorrow):
+		return SingleDay(ref.AddDays(1)), true
+	}
+
+	if rest, ok := cutPrefix(s, v.StartOf); ok {
+		if r, ok := v.parsePeriod(rest, ref); ok {
+			return SingleDay(r.Start), true
+		}
+	}
+
+	if rest, ok := cutPrefix(s, v.EndOf); ok {
+		if r, ok := v.parsePeriod(rest, ref); ok {
+			return SingleDay(r.End), true
+		}
+	}
+
+	if rest, ok := cutPrefix(s, v.In); ok {
+		if n, u, ok := v.parseAmount(rest); ok {
+			return SingleDay(u.shift(ref, n)), true
+		}
+	}
+
+	if rest, ok := cutSuffix(s, v.Ago); ok {
+		if n, u, ok := v.parseAmount(rest); ok {
+			return SingleDay(u.shift(ref, -n)), true
+		}
+	}
+
+	if rest, ok := cutPrefix(s, v.Ago); ok {
+		if n, u, ok := v.parseAmount(rest); ok {
+			return SingleDay(u.shift(ref, -n)), true
+		}
+	}
+
+	for _, direction := range []struct {
+		words []string
+		step  int
+	}{{v.Next, 1}, {v.Last, -1}, {v.This, 0}} {
+		rest, ok := cutPrefix(s, direction.words)
+		if !ok {
+			continue
+		}
+
+		if wd, ok := v.Weekdays[rest]; ok {
+			return SingleDay(relativeWeekday(ref, wd, direction.step)), true
+		}
+
+		if u, ok := v.Units[rest]; ok {
+			if u == UnitBusinessDay {
+				return SingleDay(u.shift(ref, direction.step)), direction.step != 0
+			}
+
+			return u.period(u.shift(ref, direction.step))
+		}
+	}
+
+	return Range{}, false
+}
+
+// parsePeriod parses "[next|last|this] unit", where a bare unit means the
+// current period.
+func (v *Vocabulary) parsePeriod(s string, ref Date) (Range, bool) {
+	step := 0
+	if rest, ok := cutPrefix(s, v.Next); ok {
+		s, step = rest, 1
+	} else if rest, ok := cutPrefix(s, v.Last); ok {
+		s, step = rest, -1
+	} else if rest, ok := cutPrefix(s, v.This); ok {
+		s = rest
+	}
+
+	u, ok := v.Units[s]
+	if !ok {
+		return Range{}, false
+	}
+
+	return u.period(u.shift(ref, step))
+}
+
+// parseAmount parses "<number> <unit>", such as "3 days" or "a week".
+func (v *Vocabulary) parseAmount(s string) (int, Unit, bool) {
+	number, unit, ok := strings.Cut(s, " ")
+	if !ok {
+		return 0, 0, false
+	}
+
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		if n, ok = v.Numbers[number]; !ok {
+			return 0, 0, false
+		}
+	}
+
+	u, ok := v.Units[unit]
+	return n, u, ok
+}
+
+// relativeWeekday returns the first wd after ref (step 1), the last wd before
+// ref (step -1) or the wd in ref's Monday-to-Sunday week (step 0).
+func relativeWeekday(ref Date, wd time.Weekday, step int) Date {
+	switch step {
+	case 1:
+		return ref.AddDays((int(wd)-int(ref.Weekday())+6)%7 + 1)
+	case -1:
+		return ref.AddDays(-((int(ref.Weekday())-int(wd)+6)%7 + 1))
+	}
+
+	return ref.FirstOfWeek().AddDays(isoWeekday(wd) - 1)
+}
+
+func matchPhrase(s string, phrases []string) bool {
+	for _, p := range phrases {
+		if s == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cutPrefix removes the longest of phrases followed by a space from s.
+func cutPrefix(s string, phrases []string) (string, bool) {
+	best := -1
+	for i, p := range phrases {
+		if strings.HasPrefix(s, p+" ") && (best < 0 || len(p) > len(phrases[best])) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return s, false
+	}
+
+	return s[len(phrases[best])+1:], true
+}
+
+// cutSuffix removes the longest of phrases preceded by a space from s.
+func cutSuffix(s string, phrases []string) (string, bool) {
+	best := -1
+	for i, p := range phrases {
+		if strings.HasSuffix(s, " "+p) && (best < 0 || len(p) > len(phrases[best])) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return s, false
+	}
+
+	return s[:len(s)-len(phrases[best])-1], true
+}
@@ -0,0 +1,145 @@
+package date_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseRelative(t *testing.T) {
+	ref := Date{2026, 10, 17} // Saturday
+
+	cases := []struct {
+		value string
+		want  Range
+	}{
+		{"today", SingleDay(ref)},
+		{"Yesterday", SingleDay(Date{2026, 10, 16})},
+		{" tomorrow ", SingleDay(Date{2026, 10, 18})},
+		{"next Monday", SingleDay(Date{2026, 10, 19})},
+		{"next Saturday", SingleDay(Date{2026, 10, 24})},
+		{"last Saturday", SingleDay(Date{2026, 10, 10})},
+		{"last friday", SingleDay(Date{2026, 10, 16})},
+		{"this Monday", SingleDay(Date{2026, 10, 12})},
+		{"this sunday", SingleDay(Date{2026, 10, 18})},
+		{"in 2 weeks", SingleDay(Date{2026, 10, 31})},
+		{"in a month", SingleDay(Date{2026, 11, 17})},
+		{"in 3 business days", SingleDay(Date{2026, 10, 21})},
+		{"3 days ago", SingleDay(Date{2026, 10, 14})},
+		{"two years ago", SingleDay(Date{2024, 10, 17})},
+		{"end of month", SingleDay(Date{2026, 10, 31})},
+		{"end of the week", SingleDay(Date{2026, 10, 18})},
+		{"start of next year", SingleDay(Date{2027, 1, 1})},
+		{"beginning of the quarter", SingleDay(Date{2026, 10, 1})},
+		{"end of last quarter", SingleDay(Date{2026, 9, 30})},
+		{"next day", SingleDay(Date{2026, 10, 18})},
+		{"next business day", SingleDay(Date{2026, 10, 19})},
+		{"last week", Range{Date{2026, 10, 5}, Date{2026, 10, 11}}},
+		{"this week", Range{Date{2026, 10, 12}, Date{2026, 10, 18}}},
+		{"next month", Range{Date{2026, 11, 1}, Date{2026, 11, 30}}},
+		{"last quarter", Range{Date{2026, 7, 1}, Date{2026, 9, 30}}},
+		{"this year", Range{Date{2026, 1, 1}, Date{2026, 12, 31}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParseRelative(c.value, ref)
+			if err != nil {
+				t.Fatalf("ParseRelative(%q, %v): %v", c.value, ref, err)
+			}
+
+			if got != c.want {
+				t.Errorf("ParseRelative(%q, %v) = %v; want %v", c.value, ref, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseRelative_MonthEnd(t *testing.T) {
+	ref := Date{2026, 1, 31}
+
+	cases := []struct {
+		value string
+		want  Range
+	}{
+		{"in 1 month", SingleDay(Date{2026, 2, 28})},
+		{"next month", Range{Date{2026, 2, 1}, Date{2026, 2, 28}}},
+		{"1 month ago", SingleDay(Date{2025, 12, 31})},
+		{"end of next month", SingleDay(Date{2026, 2, 28})},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParseRelative(c.value, ref)
+			if err != nil {
+				t.Fatalf("ParseRelative(%q, %v): %v", c.value, ref, err)
+			}
+
+			if got != c.want {
+				t.Errorf("ParseRelative(%q, %v) = %v; want %v", c.value, ref, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestRelativeParser_German(t *testing.T) {
+	p := RelativeParser{Vocabulary: GermanVocabulary}
+	ref := Date{2026, 10, 17}
+
+	cases := []struct {
+		value string
+		want  Range
+	}{
+		{"heute", SingleDay(ref)},
+		{"Morgen", SingleDay(Date{2026, 10, 18})},
+		{"nächsten Montag", SingleDay(Date{2026, 10, 19})},
+		{"in 2 Wochen", SingleDay(Date{2026, 10, 31})},
+		{"vor 3 Tagen", SingleDay(Date{2026, 10, 14})},
+		{"in drei Werktagen", SingleDay(Date{2026, 10, 21})},
+		{"Ende des Monats", SingleDay(Date{2026, 10, 31})},
+		{"Anfang des nächsten Jahres", SingleDay(Date{2027, 1, 1})},
+		{"letztes Quartal", Range{Date{2026, 7, 1}, Date{2026, 9, 30}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := p.Parse(c.value, ref)
+			if err != nil {
+				t.Fatalf("Parse(%q, %v): %v", c.value, ref, err)
+			}
+
+			if got != c.want {
+				t.Errorf("Parse(%q, %v) = %v; want %v", c.value, ref, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseRelative_Errors(t *testing.T) {
+	cases := []string{
+		"",
+		"someday",
+		"next",
+		"in days",
+		"in -3 days",
+		"3 fortnights ago",
+		"end of friday",
+		"this business day",
+		"next fortnight",
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			r, err := ParseRelative(c, Date{2026, 10, 17})
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("ParseRelative(%q) = %v, %v; want *ParseError", c, r, err)
+			}
+		})
+	}
+}
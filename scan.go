@@ -0,0 +1,162 @@
+package date
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ScanFormat is a set of driver value representations accepted by Scan.
+type ScanFormat int
+
+const (
+	// ScanTime accepts time.Time, which most drivers return for DATE columns.
+	ScanTime ScanFormat = 1 << iota
+	// ScanText accepts string and []byte, as returned for SQLite TEXT columns
+	// and by MySQL without parseTime. Values are parsed with
+	// ScanOptions.Parser; PostgreSQL's "infinity" and "-infinity" are always
+	// accepted.
+	ScanText
+	// ScanYYYYMMDD accepts integers such as 20261017.
+	ScanYYYYMMDD
+	// ScanUnixDays accepts integers counting days since 1970-01-01. If
+	// ScanYYYYMMDD is also set, it takes precedence.
+	ScanUnixDays
+	// ScanZeroDate accepts MySQL's zero date "0000-00-00", and 0 with
+	// ScanYYYYMMDD, scanning it as a null NullDate or the zero Date.
+	ScanZeroDate
+)
+
+// ScanOptions configures how driver values are scanned into a Date or
+// NullDate.
+type ScanOptions struct {
+	Formats ScanFormat
+	// Parser parses text values.
+	Parser Parser
+	// Zone decides which date a time.Time value represents. Drivers that
+	// return DATE columns as midnight UTC and then convert them to the
+	// connection's location need ZoneUTC; with ZoneWall, a negative offset
+	// would yield the previous day. Text values use Parser.Zone instead.
+	Zone ZonePolicy
+	// Location is used with ZoneLocation. A nil Location means UTC.
+	Location *time.Location
+}
+
+// DefaultScanOptions is used by the Scan methods of Date and NullDate. It
+// accepts time.Time, taking its wall date, and ISO 8601 text, with or
+// without a time of day.
+var DefaultScanOptions = ScanOptions{
+	Formats: ScanTime | ScanText,
+	Parser:  Parser{AllowTimestamp: true},
+}
+
+// Scanner returns an sql.Scanner that scans into d using o, for columns that
+// need different options than DefaultScanOptions:
+//
+//	err := row.Scan(opts.Scanner(&d))
+func (o ScanOptions) Scanner(d *Date) sql.Scanner {
+	return &dateScanner{d, o}
+}
+
+// NullScanner is like Scanner for a NullDate.
+func (o ScanOptions) NullScanner(d *NullDate) sql.Scanner {
+	return &nullDateScanner{d, o}
+}
+
+type dateScanner struct {
+	d *Date
+	o ScanOptions
+}
+
+func (s *dateScanner) Scan(value any) error {
+	d, _, err := s.o.scan(value, "Date")
+	if err != nil {
+		return err
+	}
+
+	*s.d = d
+	return nil
+}
+
+type nullDateScanner struct {
+	d *NullDate
+	o ScanOptions
+}
+
+func (s *nullDateScanner) Scan(value any) error {
+	if value == nil {
+		s.d.Valid, s.d.Date = false, Date{}
+		return nil
+	}
+
+	d, valid, err := s.o.scan(value, "NullDate")
+	if err != nil {
+		return err
+	}
+
+	s.d.Valid, s.d.Date = valid, d
+	return nil
+}
+
+// scan converts value to a date. valid is false for an accepted zero date.
+func (o ScanOptions) scan(value any, typ string) (d Date, valid bool, err error) {
+	switch v := value.(type) {
+	case time.Time:
+		if o.Formats&ScanTime != 0 {
+			return FromTime(o.Zone.time(v, o.Location)), true, nil
+		}
+	case string:
+		if o.Formats&ScanText != 0 {
+			return o.scanText(v, typ)
+		}
+	case []byte:
+		if o.Formats&ScanText != 0 {
+			return o.scanText(string(v), typ)
+		}
+	case int64:
+		return o.scanInt(v, typ)
+	}
+
+	return Date{}, false, fmt.Errorf("cannot scan type %T into %s", value, typ)
+}
+
+func (o ScanOptions) scanText(s string, typ string) (Date, bool, error) {
+	if o.Formats&ScanZeroDate != 0 && (s == "0000-00-00" || (len(s) > 10 && s[:11] == "0000-00-00 ")) {
+		return Date{}, false, nil
+	}
+
+	if d, ok := parseInfinity(s); ok {
+		return d, true, nil
+	}
+
+	d, err := o.Parser.Parse(s)
+	if err != nil {
+		return Date{}, false, fmt.Errorf("cannot scan %q into %s: %w", s, typ, err)
+	}
+
+	return d, true, nil
+}
+
+func (o ScanOptions) scanInt(n int64, typ string) (Date, bool, error) {
+	switch {
+	case o.Formats&ScanYYYYMMDD != 0:
+		if n == 0 && o.Formats&ScanZeroDate != 0 {
+			return Date{}, false, nil
+		}
+
+		if n < 0 {
+			return Date{}, false, fmt.Errorf("cannot scan %d into %s: negative YYYYMMDD value", n, typ)
+		}
+
+		d, err := New(int(n/10000), time.Month(n/100%100), int(n%100))
+		if err != nil {
+			return Date{}, false, fmt.Errorf("cannot scan %d into %s: %w", n, typ, err)
+		}
+
+		return d, true, nil
+	case o.Formats&ScanUnixDays != 0:
+		return FromUnixDays(int(n)), true, nil
+	}
+
+	return Date{}, false, fmt.Errorf("cannot scan type int64 into %s", typ)
+}
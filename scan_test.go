@@ -0,0 +1,302 @@
+package date_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+// fakeConnector is an in-memory database/sql driver whose only table is a
+// single column holding values, one per row.
+type fakeConnector struct {
+	values []driver.Value
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn fakeConnector
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt fakeConnector
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return 0 }
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{values: s.values}, nil }
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"d"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+func queryOne(t *testing.T, value driver.Value, dest any) error {
+	t.Helper()
+
+	db := sql.OpenDB(fakeConnector{values: []driver.Value{value}})
+	defer db.Close()
+
+	return db.QueryRow("SELECT d").Scan(dest)
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_Scan_Driver(t *testing.T) {
+	cases := []struct {
+		name  string
+		value driver.Value
+		want  Date
+	}{
+		{"time", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), Date{2026, 10, 17}},
+		{"string", "2026-10-17", Date{2026, 10, 17}},
+		{"bytes", []byte("2026-10-17"), Date{2026, 10, 17}},
+		{"sqlite timestamp", "2026-10-17 00:00:00", Date{2026, 10, 17}},
+		{"rfc3339", "2026-10-17T00:00:00Z", Date{2026, 10, 17}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Date
+			if err := queryOne(t, c.value, &got); err != nil {
+				t.Fatalf("Scan(%v): %v", c.value, err)
+			}
+
+			if got != c.want {
+				t.Errorf("Scan(%v) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDate_Scan_Errors(t *testing.T) {
+	cases := []struct {
+		name  string
+		value driver.Value
+	}{
+		{"nil", nil},
+		{"int", int64(20261017)},
+		{"float", 1.5},
+		{"invalid text", "2026-02-30"},
+		{"zero date", "0000-00-00"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Date
+			if err := queryOne(t, c.value, &got); err == nil {
+				t.Errorf("Scan(%v) = %v, <nil>; want error", c.value, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestScanOptions_Scanner(t *testing.T) {
+	cases := []struct {
+		name    string
+		options ScanOptions
+		value   driver.Value
+		want    Date
+	}{
+		{"yyyymmdd", ScanOptions{Formats: ScanYYYYMMDD}, int64(20261017), Date{2026, 10, 17}},
+		{"unix days", ScanOptions{Formats: ScanUnixDays}, int64(20743), Date{2026, 10, 17}},
+		{"unix days epoch", ScanOptions{Formats: ScanUnixDays}, int64(0), Date{1970, 1, 1}},
+		{"unix days negative", ScanOptions{Formats: ScanUnixDays}, int64(-719528), Date{0, 1, 1}},
+		{"yyyymmdd precedence", ScanOptions{Formats: ScanYYYYMMDD | ScanUnixDays}, int64(20261017), Date{2026, 10, 17}},
+		{"lenient text", ScanOptions{Formats: ScanText, Parser: Lenient}, " 20261017 ", Date{2026, 10, 17}},
+		{"zero date", ScanOptions{Formats: ScanText | ScanZeroDate}, []byte("0000-00-00"), Date{}},
+		{"zero datetime", ScanOptions{Formats: ScanText | ScanZeroDate}, "0000-00-00 00:00:00", Date{}},
+		{"zero int", ScanOptions{Formats: ScanYYYYMMDD | ScanZeroDate}, int64(0), Date{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Date
+			if err := queryOne(t, c.value, c.options.Scanner(&got)); err != nil {
+				t.Fatalf("Scan(%v): %v", c.value, err)
+			}
+
+			if got != c.want {
+				t.Errorf("Scan(%v) = %v; want %v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestScanOptions_Scanner_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		options ScanOptions
+		value   driver.Value
+	}{
+		{"time disabled", ScanOptions{Formats: ScanText}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"text disabled", ScanOptions{Formats: ScanTime}, "2026-10-17"},
+		{"invalid yyyymmdd", ScanOptions{Formats: ScanYYYYMMDD}, int64(20260230)},
+		{"negative yyyymmdd", ScanOptions{Formats: ScanYYYYMMDD}, int64(-20261017)},
+		{"zero int without zero date", ScanOptions{Formats: ScanYYYYMMDD}, int64(0)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Date
+			if err := queryOne(t, c.value, c.options.Scanner(&got)); err == nil {
+				t.Errorf("Scan(%v) = %v, <nil>; want error", c.value, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestScanOptions_NullScanner(t *testing.T) {
+	options := ScanOptions{Formats: ScanTime | ScanText | ScanYYYYMMDD | ScanZeroDate}
+
+	cases := []struct {
+		name  string
+		value driver.Value
+		want  NullDate
+	}{
+		{"nil", nil, NullDate{}},
+		{"zero date", "0000-00-00", NullDate{}},
+		{"zero int", int64(0), NullDate{}},
+		{"text", "2026-10-17", NullDateFrom(Date{2026, 10, 17})},
+		{"int", int64(20261017), NullDateFrom(Date{2026, 10, 17})},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := NullDateFrom(Date{2000, 1, 1})
+			if err := queryOne(t, c.value, options.NullScanner(&got)); err != nil {
+				t.Fatalf("Scan(%v): %v", c.value, err)
+			}
+
+			if got != c.want {
+				t.Errorf("Scan(%v) = %#v; want %#v", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNullDate_Scan_Driver(t *testing.T) {
+	var got NullDate
+	if err := queryOne(t, []byte("2026-10-17"), &got); err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	if want := NullDateFrom(Date{2026, 10, 17}); got != want {
+		t.Errorf("Scan() = %#v; want %#v", got, want)
+	}
+
+	if err := queryOne(t, nil, &got); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+
+	if got.Valid {
+		t.Errorf("Scan(nil) = %#v; want null", got)
+	}
+}
+
+func TestDefaultScanOptions(t *testing.T) {
+	defer func(o ScanOptions) { DefaultScanOptions = o }(DefaultScanOptions)
+	DefaultScanOptions = ScanOptions{Formats: ScanUnixDays}
+
+	var got Date
+	if err := queryOne(t, int64(20743), &got); err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	if want := (Date{Year: 2026, Month: time.October, Day: 17}); got != want {
+		t.Errorf("Scan() = %v; want %v", got, want)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestScanOptions_Zone(t *testing.T) {
+	newYork := time.FixedZone("EDT", -4*60*60)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	// A DATE 2026-10-17 read as midnight UTC and shown in a negative offset.
+	behind := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC).In(newYork)
+	// A DATE 2026-10-17 read as midnight in a positive offset and shown in UTC.
+	ahead := time.Date(2026, 10, 17, 0, 0, 0, 0, tokyo).UTC()
+
+	cases := []struct {
+		name    string
+		options ScanOptions
+		value   time.Time
+		want    Date
+	}{
+		{"wall negative offset", ScanOptions{Formats: ScanTime}, behind, Date{2026, 10, 16}},
+		{"utc negative offset", ScanOptions{Formats: ScanTime, Zone: ZoneUTC}, behind, Date{2026, 10, 17}},
+		{"location negative offset", ScanOptions{Formats: ScanTime, Zone: ZoneLocation}, behind, Date{2026, 10, 17}},
+		{"wall positive offset", ScanOptions{Formats: ScanTime}, ahead, Date{2026, 10, 16}},
+		{"utc positive offset", ScanOptions{Formats: ScanTime, Zone: ZoneUTC}, ahead, Date{2026, 10, 16}},
+		{"location positive offset", ScanOptions{Formats: ScanTime, Zone: ZoneLocation, Location: tokyo}, ahead, Date{2026, 10, 17}},
+		{"wall in own zone", ScanOptions{Formats: ScanTime}, time.Date(2026, 10, 17, 0, 0, 0, 0, tokyo), Date{2026, 10, 17}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Date
+			if err := queryOne(t, c.value, c.options.Scanner(&got)); err != nil {
+				t.Fatalf("Scan(%v): %v", c.value, err)
+			}
+
+			if got != c.want {
+				t.Errorf("Scan(%v) = %v; want %v", c.value, got, c.want)
+			}
+
+			var null NullDate
+			if err := queryOne(t, c.value, c.options.NullScanner(&null)); err != nil {
+				t.Fatalf("Scan(%v): %v", c.value, err)
+			}
+
+			if null != NullDateFrom(c.want) {
+				t.Errorf("Scan(%v) = %#v; want %v", c.value, null, c.want)
+			}
+		})
+	}
+}
+
+func TestDefaultScanOptions_Zone(t *testing.T) {
+	defer func(o ScanOptions) { DefaultScanOptions = o }(DefaultScanOptions)
+	DefaultScanOptions.Zone = ZoneUTC
+
+	value := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC).In(time.FixedZone("PDT", -7*60*60))
+
+	var d Date
+	if err := d.Scan(value); err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	var n NullDate
+	if err := n.Scan(value); err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	want := Date{Year: 2026, Month: time.October, Day: 17}
+	if d != want || n != NullDateFrom(want) {
+		t.Errorf("Scan() = %v, %#v; want %v", d, n, want)
+	}
+}
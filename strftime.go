@@ -0,0 +1,126 @@
+package date
+
+import "fmt"
+
+var strftimeChunks = map[byte][]chunk{
+	'Y': {{kind: chunkLongYear}},
+	'y': {{kind: chunkYear}},
+	'G': {{kind: chunkISOYear}},
+	'g': {{kind: chunkISOShortYear}},
+	'm': {{kind: chunkZeroMonth}},
+	'B': {{kind: chunkLongMonth}},
+	'b': {{kind: chunkMonth}},
+	'h': {{kind: chunkMonth}},
+	'd': {{kind: chunkZeroDay}},
+	'e': {{kind: chunkUnderDay}},
+	'j': {{kind: chunkZeroYearDay}},
+	'A': {{kind: chunkLongWeekDay}},
+	'a': {{kind: chunkWeekDay}},
+	'u': {{kind: chunkISOWeekDay}},
+	'w': {{kind: chunkNumWeekDay}},
+	'V': {{kind: chunkZeroISOWeek}},
+	'U': {{kind: chunkSundayWeek}},
+	'W': {{kind: chunkMondayWeek}},
+	'F': {{kind: chunkLongYear}, {kind: chunkLiteral, literal: "-"}, {kind: chunkZeroMonth}, {kind: chunkLiteral, literal: "-"}, {kind: chunkZeroDay}},
+	'D': {{kind: chunkZeroMonth}, {kind: chunkLiteral, literal: "/"}, {kind: chunkZeroDay}, {kind: chunkLiteral, literal: "/"}, {kind: chunkYear}},
+	'%': {{kind: chunkLiteral, literal: "%"}},
+	'n': {{kind: chunkLiteral, literal: "\n"}},
+	't': {{kind: chunkLiteral, literal: "\t"}},
+}
+
+// strftimeUnpadded holds the directives that accept the glibc "-" flag, which
+// removes the padding, as in "%-d".
+var strftimeUnpadded = map[byte]chunkKind{
+	'd': chunkDay,
+	'e': chunkDay,
+	'm': chunkNumMonth,
+	'j': chunkNumYearDay,
+	'V': chunkNumISOWeek,
+}
+
+// NewStrftimeLayout compiles a C/Python strftime pattern such as "%Y-%m-%d" or
+// "%d %B %Y". Only date directives are accepted: %Y %y %G %g %m %B %b %h %d %e
+// %j %A %a %u %w %V %U %W %F %D and the literals %% %n %t. The glibc "-" flag
+// removes padding from %d, %e, %m, %j and %V. Locale-dependent directives
+// such as %x and time directives are rejected.
+func NewStrftimeLayout(pattern string) (Layout, error) {
+	var chunks []chunk
+	literalStart := 0
+
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			continue
+		}
+
+		if literalStart < i {
+			chunks = append(chunks, chunk{kind: chunkLiteral, literal: pattern[literalStart:i]})
+		}
+
+		if i+1 == len(pattern) {
+			return Layout{}, fmt.Errorf("strftime pattern %q: trailing %%", pattern)
+		}
+
+		directive := pattern[i+1]
+		if directive == '-' && i+2 < len(pattern) {
+			kind, ok := strftimeUnpadded[pattern[i+2]]
+			if !ok {
+				return Layout{}, fmt.Errorf("strftime pattern %q: %%-%c is not supported", pattern, pattern[i+2])
+			}
+
+			chunks = append(chunks, chunk{kind: kind})
+			i += 2
+			literalStart = i + 1
+			continue
+		}
+
+		directiveChunks, ok := strftimeChunks[directive]
+		if !ok {
+			if isStrftimeTime(directive) {
+				return Layout{}, fmt.Errorf("strftime pattern %q: %w", pattern, errTimeComponent("%"+string(directive)))
+			}
+			return Layout{}, fmt.Errorf("strftime pattern %q: %%%c is not supported", pattern, directive)
+		}
+
+		chunks = append(chunks, directiveChunks...)
+		i++
+		literalStart = i + 1
+	}
+
+	if literalStart < len(pattern) {
+		chunks = append(chunks, chunk{kind: chunkLiteral, literal: pattern[literalStart:]})
+	}
+
+	return Layout{chunks: chunks}, nil
+}
+
+func isStrftimeTime(directive byte) bool {
+	switch directive {
+	case 'H', 'I', 'k', 'l', 'M', 'S', 'f', 'L', 'N', 'p', 'P', 'r', 'R', 'T', 'X', 'c', 'z', 'Z', 's':
+		return true
+	}
+
+	return false
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) FormatStrftime(pattern string) (string, error) {
+	if err := checkFormat(d); err != nil {
+		return "", err
+	}
+
+	l, err := NewStrftimeLayout(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return l.Format(d), nil
+}
+
+func ParseStrftime(pattern, value string) (Date, error) {
+	l, err := NewStrftimeLayout(pattern)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return l.Parse(value)
+}
@@ -0,0 +1,131 @@
+package date_test
+
+import (
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_FormatStrftime(t *testing.T) {
+	cases := []struct {
+		date    Date
+		pattern string
+		want    string
+	}{
+		{Date{2026, 10, 17}, "%Y-%m-%d", "2026-10-17"},
+		{Date{2026, 1, 5}, "%d.%m.%y", "05.01.26"},
+		{Date{2026, 1, 5}, "%-d/%-m/%Y", "5/1/2026"},
+		{Date{2026, 1, 5}, "%e %b %Y", " 5 Jan 2026"},
+		{Date{2026, 10, 17}, "%A, %d %B %Y", "Saturday, 17 October 2026"},
+		{Date{2026, 10, 17}, "%a %h", "Sat Oct"},
+		{Date{2026, 10, 17}, "%F", "2026-10-17"},
+		{Date{2026, 10, 17}, "%D", "10/17/26"},
+		{Date{2026, 2, 3}, "%Y%j", "2026034"},
+		{Date{2026, 2, 3}, "%-j", "34"},
+		{Date{2027, 1, 1}, "%G-W%V-%u", "2026-W53-5"},
+		{Date{2024, 12, 30}, "%G-W%V-%u %g", "2025-W01-1 25"},
+		{Date{2026, 1, 4}, "%w %u", "0 7"},
+		{Date{2026, 1, 4}, "%U %W", "01 00"},
+		{Date{2026, 1, 5}, "%U %W", "01 01"},
+		{Date{2026, 1, 5}, "100%% %n%t", "100% \n\t"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.pattern, func(t *testing.T) {
+			got, err := c.date.FormatStrftime(c.pattern)
+			if err != nil {
+				t.Fatalf("%v.FormatStrftime(%q): %v", c.date, c.pattern, err)
+			}
+
+			if got != c.want {
+				t.Errorf("%v.FormatStrftime(%q) = %q; want %q", c.date, c.pattern, got, c.want)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseStrftime(t *testing.T) {
+	cases := []struct {
+		pattern string
+		value   string
+		want    Date
+	}{
+		{"%Y-%m-%d", "2026-10-17", Date{2026, 10, 17}},
+		{"%d.%m.%y", "05.01.26", Date{2026, 1, 5}},
+		{"%-d/%-m/%Y", "5/1/2026", Date{2026, 1, 5}},
+		{"%-d/%-m/%Y", "15/11/2026", Date{2026, 11, 15}},
+		{"%e %b %Y", " 5 Jan 2026", Date{2026, 1, 5}},
+		{"%A, %d %B %Y", "Saturday, 17 October 2026", Date{2026, 10, 17}},
+		{"%Y%j", "2026034", Date{2026, 2, 3}},
+		{"%G-W%V-%u", "2026-W53-5", Date{2027, 1, 1}},
+		{"%G-W%V", "2025-W01", Date{2024, 12, 30}},
+		{"%Y %U %w", "2026 01 0", Date{2026, 1, 4}},
+		{"%Y %W %u", "2026 00 7", Date{2026, 1, 4}},
+		{"%Y %W %a", "2026 01 Mon", Date{2026, 1, 5}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParseStrftime(c.pattern, c.value)
+			if err != nil {
+				t.Fatalf("ParseStrftime(%q, %q): %v", c.pattern, c.value, err)
+			}
+
+			if !got.Equal(c.want) {
+				t.Errorf("ParseStrftime(%q, %q) = %v; want %v", c.pattern, c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseStrftime_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		pattern string
+		value   string
+	}{
+		{"time directive", "%Y-%m-%d %H:%M", "2026-10-17 10:00"},
+		{"unsupported directive", "%x", "10/17/26"},
+		{"trailing percent", "%Y%", "2026"},
+		{"invalid day", "%Y-%m-%d", "2026-02-30"},
+		{"week without week-based year", "%Y-W%V", "2026-W01"},
+		{"week-based year without week", "%G-%m-%d", "2026-10-17"},
+		{"week 53 in a 52 week year", "%G-W%V", "2025-W53"},
+		{"week of year without weekday", "%Y %U", "2026 01"},
+		{"weekday out of range", "%G-W%V-%u", "2026-W01-8"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d, err := ParseStrftime(c.pattern, c.value)
+			if err == nil {
+				t.Errorf("ParseStrftime(%q, %q) = %v, <nil>; want error", c.pattern, c.value, d)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestStrftimeLayout_RoundTrip(t *testing.T) {
+	patterns := []string{"%G-W%V-%u", "%Y %U %w", "%Y %W %u", "%Y-%j"}
+	for _, pattern := range patterns {
+		layout, err := NewStrftimeLayout(pattern)
+		if err != nil {
+			t.Fatalf("NewStrftimeLayout(%q): %v", pattern, err)
+		}
+
+		for d := (Date{2019, 12, 1}); d.IsBefore(Date{2028, 2, 1}); d = d.AddDays(1) {
+			s := layout.Format(d)
+			got, err := layout.Parse(s)
+			if err != nil {
+				t.Fatalf("%q: Parse(%q): %v", pattern, s, err)
+			}
+
+			if !got.Equal(d) {
+				t.Fatalf("%q: Parse(Format(%v)) = %v", pattern, d, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// Package date represents calendar dates without a time of day or location.
+//
+// Unlike version 1, a Date cannot be built from a literal: its storage is
+// unexported and every constructor returns a valid date, so methods never
+// see "February 30". The zero Date is 0001-01-01, like the zero time.Time.
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// minYear and maxYear bound the dates a Date holds; days since 0001-01-01 fit
+// in an int32 well beyond them.
+const (
+	minYear = -999_999
+	maxYear = 999_999
+)
+
+// Date is a day in the proleptic Gregorian calendar between the years
+// -999999 and 999999. Dates compare with ==, and Compare orders them.
+type Date struct {
+	// days counts from 0001-01-01.
+	days int32
+}
+
+// New returns the date year-month-day, or an error if there is no such day.
+func New(year int, month time.Month, day int) (Date, error) {
+	if year < minYear || year > maxYear {
+		return Date{}, fmt.Errorf("year must be between %d-%d (inclusive), got %d", minYear, maxYear, year)
+	}
+	if month < time.January || month > time.December {
+		return Date{}, fmt.Errorf("month must be between 1-12 (inclusive), got %d", month)
+	}
+	if last := daysInMonth(year, month); day < 1 || day > last {
+		return Date{}, fmt.Errorf("day must be between 1-%d (inclusive) in %04d-%02d, got %d", last, year, month, day)
+	}
+
+	return Date{int32(daysFromCivil(year, month, day))}, nil
+}
+
+// MustNew is like New but panics if the date does not exist. It is meant for
+// constants in code and tests.
+func MustNew(year int, month time.Month, day int) Date {
+	d, err := New(year, month, day)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// FromTime returns the date of t in t's location, clamped to the supported
+// range.
+func FromTime(t time.Time) Date {
+	y, m, d := t.Date()
+	return fromDays(daysFromCivil(y, m, d))
+}
+
+// Parse parses an ISO 8601 date, "YYYY-MM-DD", in the form String writes:
+// the year may have a minus sign and up to six digits, such as "-0044-03-15"
+// or "12026-03-15".
+func Parse(value string) (Date, error) {
+	s, sign := value, 1
+	if strings.HasPrefix(s, "-") {
+		s, sign = s[1:], -1
+	}
+
+	n := len(s) - len("-MM-DD")
+	if n < 4 || n > 6 || s[n] != '-' || s[n+3] != '-' {
+		return Date{}, fmt.Errorf("date %q: expected YYYY-MM-DD", value)
+	}
+
+	var fields [3]int
+	for i, f := range []string{s[:n], s[n+1 : n+3], s[n+4:]} {
+		for _, c := range []byte(f) {
+			if c < '0' || c > '9' {
+				return Date{}, fmt.Errorf("date %q: expected YYYY-MM-DD", value)
+			}
+			fields[i] = fields[i]*10 + int(c-'0')
+		}
+	}
+
+	d, err := New(sign*fields[0], time.Month(fields[1]), fields[2])
+	if err != nil {
+		return Date{}, fmt.Errorf("date %q: %w", value, err)
+	}
+
+	return d, nil
+}
+
+func (d Date) Year() int {
+	y, _, _ := d.Date()
+	return y
+}
+
+func (d Date) Month() time.Month {
+	_, m, _ := d.Date()
+	return m
+}
+
+func (d Date) Day() int {
+	_, _, day := d.Date()
+	return day
+}
+
+// Date returns the year, month and day of d.
+func (d Date) Date() (year int, month time.Month, day int) {
+	return civilFromDays(int(d.days))
+}
+
+func (d Date) Weekday() time.Weekday {
+	// 0001-01-01 was a Monday.
+	return time.Weekday((int(d.days)%7 + 7 + 1) % 7)
+}
+
+// AddDays returns d moved by days, clamped to the supported range.
+func (d Date) AddDays(days int) Date {
+	return fromDays(int(d.days) + days)
+}
+
+// AddMonths returns the same day months later. Unlike version 1, a day that
+// does not exist in the target month becomes the last day of that month:
+// January 31 plus one month is the end of February.
+func (d Date) AddMonths(months int) Date {
+	y, m, day := d.Date()
+
+	total := y*12 + int(m) - 1 + months
+	y, m = floorDiv(total, 12), time.Month(total-floorDiv(total, 12)*12+1)
+	if y < minYear {
+		return fromDays(minDays)
+	}
+	if y > maxYear {
+		return fromDays(maxDays)
+	}
+
+	if last := daysInMonth(y, m); day > last {
+		day = last
+	}
+
+	return Date{int32(daysFromCivil(y, m, day))}
+}
+
+// AddYears is AddMonths(12 * years).
+func (d Date) AddYears(years int) Date {
+	return d.AddMonths(12 * years)
+}
+
+// Sub returns the number of days from o to d.
+func (d Date) Sub(o Date) int {
+	return int(d.days) - int(o.days)
+}
+
+func (d Date) Before(o Date) bool {
+	return d.days < o.days
+}
+
+func (d Date) After(o Date) bool {
+	return d.days > o.days
+}
+
+// Compare returns -1, 0 or 1 as d is before, equal to or after o.
+func (d Date) Compare(o Date) int {
+	switch {
+	case d.days < o.days:
+		return -1
+	case d.days > o.days:
+		return 1
+	}
+	return 0
+}
+
+// Time returns midnight at the start of d in l.
+func (d Date) Time(l *time.Location) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, l)
+}
+
+// String returns d as "YYYY-MM-DD", with a minus sign before negative years
+// and more digits for years past 9999.
+func (d Date) String() string {
+	y, m, day := d.Date()
+	if y < 0 {
+		return fmt.Sprintf("-%04d-%02d-%02d", -y, m, day)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", y, m, day)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return d.UnmarshalText([]byte(s))
+}
+
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan accepts time.Time, string and []byte.
+func (d *Date) Scan(value any) error {
+	switch v := value.(type) {
+	case time.Time:
+		*d = FromTime(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	}
+
+	return fmt.Errorf("cannot scan type %T into Date", value)
+}
+
+var (
+	minDays = daysFromCivil(minYear, time.January, 1)
+	maxDays = daysFromCivil(maxYear, time.December, 31)
+)
+
+func fromDays(days int) Date {
+	if days < minDays {
+		days = minDays
+	} else if days > maxDays {
+		days = maxDays
+	}
+
+	return Date{int32(days)}
+}
+
+// daysFromCivil returns the number of days from 0001-01-01 to a valid date.
+func daysFromCivil(year int, month time.Month, day int) int {
+	y := year
+	if month <= time.February {
+		y--
+	}
+
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	mp := (int(month) + 9) % 12
+	doy := (153*mp+2)/5 + day - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+
+	// 0000-03-01 is day 0 of era 0; 0001-01-01 is 306 days later.
+	return era*146097 + doe - 306
+}
+
+func civilFromDays(days int) (int, time.Month, int) {
+	z := days + 306
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day := doy - (153*mp+2)/5 + 1
+	month := time.Month((mp+2)%12 + 1)
+
+	year := yoe + era*400
+	if month <= time.February {
+		year++
+	}
+
+	return year, month, day
+}
+
+func daysInMonth(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+
+	return 31
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+
+	return q
+}
@@ -0,0 +1,264 @@
+package date_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/beonode/date"
+	. "github.com/beonode/date/v2"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+		valid bool
+	}{
+		{2026, time.October, 18, true},
+		{2024, time.February, 29, true},
+		{2026, time.February, 29, false},
+		{2000, time.February, 29, true},
+		{1900, time.February, 29, false},
+		{2026, time.April, 31, false},
+		{2026, time.December, 31, true},
+		{2026, 13, 1, false},
+		{2026, 0, 1, false},
+		{2026, time.January, 0, false},
+		{-4, time.February, 29, true},
+		{999_999, time.December, 31, true},
+		{1_000_000, time.January, 1, false},
+		{-1_000_000, time.December, 31, false},
+	}
+
+	for _, c := range cases {
+		d, err := New(c.year, c.month, c.day)
+		if (err == nil) != c.valid {
+			t.Errorf("New(%d, %d, %d) error = %v; want valid %t", c.year, c.month, c.day, err, c.valid)
+			continue
+		}
+
+		if !c.valid {
+			continue
+		}
+
+		if y, m, day := d.Date(); y != c.year || m != c.month || day != c.day {
+			t.Errorf("New(%d, %d, %d).Date() = %d, %d, %d", c.year, c.month, c.day, y, m, day)
+		}
+	}
+}
+
+func TestDate_Zero(t *testing.T) {
+	var d Date
+	if d != MustNew(1, time.January, 1) {
+		t.Errorf("zero Date = %v; want 0001-01-01", d)
+	}
+
+	if d.Weekday() != time.Monday {
+		t.Errorf("zero Date Weekday() = %v; want Monday", d.Weekday())
+	}
+}
+
+func TestDate_AgreesWithTime(t *testing.T) {
+	start := time.Date(-800, time.January, 1, 0, 0, 0, 0, time.UTC)
+	d := FromTime(start)
+
+	for tm := start; tm.Year() < 2800; tm = tm.AddDate(0, 0, 1) {
+		y, m, day := tm.Date()
+		if gy, gm, gd := d.Date(); gy != y || gm != m || gd != day {
+			t.Fatalf("%v: Date() = %d-%d-%d", tm, gy, gm, gd)
+		}
+
+		if d.Weekday() != tm.Weekday() {
+			t.Fatalf("%v: Weekday() = %v; want %v", tm, d.Weekday(), tm.Weekday())
+		}
+
+		if !d.Time(time.UTC).Equal(tm) {
+			t.Fatalf("%v: Time() = %v", tm, d.Time(time.UTC))
+		}
+
+		d = d.AddDays(1)
+	}
+}
+
+func TestDate_AddMonths(t *testing.T) {
+	cases := []struct {
+		d      Date
+		months int
+		want   Date
+	}{
+		{MustNew(2026, time.January, 31), 1, MustNew(2026, time.February, 28)},
+		{MustNew(2024, time.March, 31), -1, MustNew(2024, time.February, 29)},
+		{MustNew(2026, time.October, 18), 3, MustNew(2027, time.January, 18)},
+		{MustNew(2026, time.October, 18), -10, MustNew(2025, time.December, 18)},
+		{MustNew(999_999, time.December, 1), 1, MustNew(999_999, time.December, 31)},
+	}
+
+	for _, c := range cases {
+		if got := c.d.AddMonths(c.months); got != c.want {
+			t.Errorf("%v.AddMonths(%d) = %v; want %v", c.d, c.months, got, c.want)
+		}
+	}
+
+	if got, want := MustNew(2024, time.February, 29).AddYears(1), MustNew(2025, time.February, 28); got != want {
+		t.Errorf("AddYears(1) = %v; want %v", got, want)
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	a, b := MustNew(2026, time.October, 17), MustNew(2026, time.October, 18)
+
+	if !a.Before(b) || a.After(b) || a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("%v and %v compare wrongly", a, b)
+	}
+
+	if b.Sub(a) != 1 || a.Sub(b) != -1 {
+		t.Errorf("Sub() = %d, %d; want 1, -1", b.Sub(a), a.Sub(b))
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{"2026-10-18", "0001-01-01", "2024-02-29", "0000-02-29", "-0044-03-15", "12026-03-15", "-999999-01-01", "999999-12-31"} {
+		d, err := Parse(s)
+		if err != nil || d.String() != s {
+			t.Errorf("Parse(%q) = %v, %v", s, d, err)
+		}
+	}
+
+	for _, s := range []string{"", "2026-02-29", "2026-1-18", "2026/10/18", "+026-10-18", "2026-10-18T00:00:00Z", "-026-10-18", "--2026-10-18", "1000000-01-01", "-1000000-01-01", "-2026-10-1"} {
+		if d, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) = %v, <nil>; want error", s, d)
+		}
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	d := MustNew(2026, time.October, 18)
+
+	data, err := json.Marshal(d)
+	if err != nil || string(data) != `"2026-10-18"` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+
+	var back Date
+	if err := json.Unmarshal(data, &back); err != nil || back != d {
+		t.Errorf("json.Unmarshal() = %v, %v; want %v", back, err, d)
+	}
+
+	if err := json.Unmarshal([]byte(`"2026-02-30"`), &back); err == nil {
+		t.Error("json.Unmarshal(2026-02-30) = <nil>; want error")
+	}
+}
+
+func TestDate_RoundTrip(t *testing.T) {
+	for _, d := range []Date{
+		MustNew(-999999, time.January, 1),
+		MustNew(-44, time.March, 15),
+		MustNew(12026, time.March, 15),
+		MustNew(999999, time.December, 31),
+	} {
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v): %v", d, err)
+		}
+
+		var fromJSON Date
+		if err := json.Unmarshal(data, &fromJSON); err != nil || fromJSON != d {
+			t.Errorf("json.Unmarshal(%s) = %v, %v; want %v", data, fromJSON, err, d)
+		}
+
+		text, err := d.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): %v", d, err)
+		}
+
+		var fromText Date
+		if err := fromText.UnmarshalText(text); err != nil || fromText != d {
+			t.Errorf("UnmarshalText(%s) = %v, %v; want %v", text, fromText, err, d)
+		}
+
+		v, err := d.Value()
+		if err != nil {
+			t.Fatalf("Value(%v): %v", d, err)
+		}
+
+		var scanned Date
+		if err := scanned.Scan(v); err != nil || scanned != d {
+			t.Errorf("Scan(%v) = %v, %v; want %v", v, scanned, err, d)
+		}
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	want := MustNew(2026, time.October, 18)
+
+	for _, v := range []any{"2026-10-18", []byte("2026-10-18"), time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)} {
+		var d Date
+		if err := d.Scan(v); err != nil || d != want {
+			t.Errorf("Scan(%#v) = %v, %v; want %v", v, d, err, want)
+		}
+	}
+
+	var d Date
+	if err := d.Scan(int64(1)); err == nil {
+		t.Error("Scan(int64) = <nil>; want error")
+	}
+
+	if v, err := want.Value(); err != nil || v != "2026-10-18" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+}
+
+func TestFromV1(t *testing.T) {
+	for _, d := range []v1.Date{
+		{Year: 2026, Month: 10, Day: 18},
+		{Year: 2024, Month: 2, Day: 29},
+		{Year: -4, Month: 2, Day: 29},
+		{Year: 1, Month: 1, Day: 1},
+	} {
+		got, err := FromV1(d)
+		if err != nil {
+			t.Fatalf("FromV1(%v): %v", d, err)
+		}
+
+		if got.V1() != d {
+			t.Errorf("FromV1(%v).V1() = %v", d, got.V1())
+		}
+	}
+
+	for _, d := range []v1.Date{
+		{Year: 2026, Month: 2, Day: 30},
+		{Year: 2026, Month: 13, Day: 1},
+		{},
+		v1.Infinity,
+		v1.NegInfinity,
+	} {
+		if got, err := FromV1(d); err == nil {
+			t.Errorf("FromV1(%v) = %v, <nil>; want error", d, got)
+		}
+	}
+
+	if got, err := FromV1(v1.Date{Year: 2026, Month: 2, Day: 30}.Normalize()); err != nil || got != MustNew(2026, time.March, 2) {
+		t.Errorf("FromV1(Normalize()) = %v, %v; want 2026-03-02", got, err)
+	}
+}
+
+func TestToday(t *testing.T) {
+	clock := v1.NewFakeClock(time.Date(2026, 10, 18, 23, 30, 0, 0, time.UTC))
+	if got, want := TodayFrom(clock, time.UTC), MustNew(2026, time.October, 18); got != want {
+		t.Errorf("TodayFrom(UTC) = %v; want %v", got, want)
+	}
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	if got, want := TodayFrom(clock, tokyo), MustNew(2026, time.October, 19); got != want {
+		t.Errorf("TodayFrom(JST) = %v; want %v", got, want)
+	}
+
+	defer func(c v1.Clock) { v1.DefaultClock = c }(v1.DefaultClock)
+	v1.DefaultClock = clock
+
+	if got, want := Today(time.UTC), MustNew(2026, time.October, 18); got != want {
+		t.Errorf("Today(UTC) = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/beonode/date/v2
+
+go 1.23
+
+require github.com/beonode/date v1.1.0
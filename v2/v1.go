@@ -0,0 +1,36 @@
+package date
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/beonode/date"
+)
+
+// FromV1 converts a version 1 date. It returns an error for dates that v1
+// accepts but that do not exist, such as 2026-02-30, and for the infinities;
+// call Normalize first to keep the v1 overflow behaviour.
+func FromV1(d v1.Date) (Date, error) {
+	if d.IsInfinite() {
+		return Date{}, fmt.Errorf("cannot convert %v to a v2 date", d)
+	}
+
+	return New(d.Year, d.Month, d.Day)
+}
+
+// V1 returns d as a version 1 date.
+func (d Date) V1() v1.Date {
+	y, m, day := d.Date()
+	return v1.Date{Year: y, Month: m, Day: day}
+}
+
+// Today returns the current date in l according to the version 1
+// DefaultClock, so that tests can set one clock for both versions.
+func Today(l *time.Location) Date {
+	return TodayFrom(v1.DefaultClock, l)
+}
+
+// TodayFrom returns the current date in l according to c.
+func TodayFrom(c v1.Clock, l *time.Location) Date {
+	return FromTime(c.Now().In(l))
+}
@@ -0,0 +1,190 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// ValueFormat is the driver.Value representation produced by Value.
+type ValueFormat int
+
+const (
+	// ValueString produces "YYYY-MM-DD", or "infinity" and "-infinity" for
+	// Infinity and NegInfinity. The other formats cannot represent them.
+	ValueString ValueFormat = iota
+	// ValueTime produces a time.Time at midnight UTC.
+	ValueTime
+	// ValueYYYYMMDD produces an int64 such as 20261017. Years outside 0-9999
+	// cannot be represented.
+	ValueYYYYMMDD
+	// ValueUnixDays produces an int64 counting days since 1970-01-01.
+	ValueUnixDays
+)
+
+func (f ValueFormat) String() string {
+	switch f {
+	case ValueString:
+		return "ValueString"
+	case ValueTime:
+		return "ValueTime"
+	case ValueYYYYMMDD:
+		return "ValueYYYYMMDD"
+	case ValueUnixDays:
+		return "ValueUnixDays"
+	}
+
+	return fmt.Sprintf("ValueFormat(%d)", int(f))
+}
+
+// DefaultValueFormat is used by the Value methods of Date and NullDate.
+var DefaultValueFormat = ValueString
+
+// Valuer wraps d so that it is passed to a driver in format f, regardless of
+// DefaultValueFormat:
+//
+//	_, err := db.Exec("INSERT INTO t (d) VALUES (?)", date.ValueYYYYMMDD.Valuer(d))
+func (f ValueFormat) Valuer(d Date) driver.Valuer {
+	return dateValuer{d, f}
+}
+
+// NullValuer is like Valuer for a NullDate, passing nil for a null date.
+func (f ValueFormat) NullValuer(d NullDate) driver.Valuer {
+	return nullDateValuer{d, f}
+}
+
+type dateValuer struct {
+	d Date
+	f ValueFormat
+}
+
+func (v dateValuer) Value() (driver.Value, error) {
+	return v.f.value(v.d)
+}
+
+type nullDateValuer struct {
+	d NullDate
+	f ValueFormat
+}
+
+func (v nullDateValuer) Value() (driver.Value, error) {
+	if !v.d.Valid {
+		return nil, nil
+	}
+
+	return v.f.value(v.d.Date)
+}
+
+func (f ValueFormat) value(d Date) (driver.Value, error) {
+	if d.IsInfinite() && f != ValueString {
+		return nil, fmt.Errorf("date %v cannot be represented as %v", d, f)
+	}
+
+	switch f {
+	case ValueString:
+		return d.String(), nil
+	case ValueTime:
+		return d.Time(time.UTC), nil
+	case ValueYYYYMMDD:
+		if d.Year < 0 || d.Year > 9999 {
+			return nil, fmt.Errorf("date %v cannot be represented as YYYYMMDD", d)
+		}
+		return int64(d.Year*10000 + int(d.Month)*100 + d.Day), nil
+	case ValueUnixDays:
+		return int64(d.UnixDays()), nil
+	}
+
+	return nil, fmt.Errorf("unknown %v", f)
+}
+
+// DateAsTime, DateAsYYYYMMDD and DateAsUnixDays are struct field types for
+// columns stored in one representation, whatever DefaultValueFormat and
+// DefaultScanOptions say:
+//
+//	type Payment struct {
+//		Booked date.DateAsYYYYMMDD `db:"booked"`
+//	}
+//
+// Value writes the representation of the type and Scan accepts only it, using
+// the other settings of DefaultScanOptions.
+type DateAsTime struct{ Date }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d DateAsTime) Value() (driver.Value, error) {
+	return ValueTime.value(d.Date)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *DateAsTime) Scan(value any) error {
+	return scanOptions(ScanTime).Scanner(&d.Date).Scan(value)
+}
+
+type DateAsYYYYMMDD struct{ Date }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d DateAsYYYYMMDD) Value() (driver.Value, error) {
+	return ValueYYYYMMDD.value(d.Date)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *DateAsYYYYMMDD) Scan(value any) error {
+	return scanOptions(ScanYYYYMMDD).Scanner(&d.Date).Scan(value)
+}
+
+type DateAsUnixDays struct{ Date }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d DateAsUnixDays) Value() (driver.Value, error) {
+	return ValueUnixDays.value(d.Date)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *DateAsUnixDays) Scan(value any) error {
+	return scanOptions(ScanUnixDays).Scanner(&d.Date).Scan(value)
+}
+
+// NullDateAsTime, NullDateAsYYYYMMDD and NullDateAsUnixDays are the NullDate
+// forms of DateAsTime, DateAsYYYYMMDD and DateAsUnixDays. A null date is NULL.
+type NullDateAsTime struct{ NullDate }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d NullDateAsTime) Value() (driver.Value, error) {
+	return ValueTime.NullValuer(d.NullDate).Value()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *NullDateAsTime) Scan(value any) error {
+	return scanOptions(ScanTime).NullScanner(&d.NullDate).Scan(value)
+}
+
+type NullDateAsYYYYMMDD struct{ NullDate }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d NullDateAsYYYYMMDD) Value() (driver.Value, error) {
+	return ValueYYYYMMDD.NullValuer(d.NullDate).Value()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *NullDateAsYYYYMMDD) Scan(value any) error {
+	return scanOptions(ScanYYYYMMDD).NullScanner(&d.NullDate).Scan(value)
+}
+
+type NullDateAsUnixDays struct{ NullDate }
+
+//goland:noinspection GoMixedReceiverTypes
+func (d NullDateAsUnixDays) Value() (driver.Value, error) {
+	return ValueUnixDays.NullValuer(d.NullDate).Value()
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *NullDateAsUnixDays) Scan(value any) error {
+	return scanOptions(ScanUnixDays).NullScanner(&d.NullDate).Scan(value)
+}
+
+// scanOptions returns DefaultScanOptions accepting only formats, keeping
+// ScanZeroDate if it is set.
+func scanOptions(formats ScanFormat) ScanOptions {
+	o := DefaultScanOptions
+	o.Formats = formats | o.Formats&ScanZeroDate
+	return o
+}
@@ -0,0 +1,189 @@
+package date_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestValueFormat_Valuer(t *testing.T) {
+	d := Date{2026, 10, 17}
+
+	cases := []struct {
+		format ValueFormat
+		want   driver.Value
+	}{
+		{ValueString, "2026-10-17"},
+		{ValueTime, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{ValueYYYYMMDD, int64(20261017)},
+		{ValueUnixDays, int64(20743)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.format.String(), func(t *testing.T) {
+			got, err := driver.DefaultParameterConverter.ConvertValue(c.format.Valuer(d))
+			if err != nil {
+				t.Fatalf("ConvertValue(): %v", err)
+			}
+
+			if got != c.want {
+				t.Errorf("Value() = %#v; want %#v", got, c.want)
+			}
+
+			got, err = c.format.NullValuer(NullDateFrom(d)).Value()
+			if err != nil {
+				t.Fatalf("NullValuer().Value(): %v", err)
+			}
+
+			if got != c.want {
+				t.Errorf("NullValuer().Value() = %#v; want %#v", got, c.want)
+			}
+
+			got, err = c.format.NullValuer(NullDate{}).Value()
+			if err != nil || got != nil {
+				t.Errorf("NullValuer(null).Value() = %#v, %v; want <nil>, <nil>", got, err)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestValueFormat_Valuer_Errors(t *testing.T) {
+	cases := []struct {
+		format ValueFormat
+		date   Date
+	}{
+		{ValueYYYYMMDD, Date{-1, 12, 31}},
+		{ValueYYYYMMDD, Date{10000, 1, 1}},
+		{ValueFormat(42), Date{2026, 10, 17}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.format.String(), func(t *testing.T) {
+			if got, err := c.format.Valuer(c.date).Value(); err == nil {
+				t.Errorf("%v.Valuer(%v).Value() = %#v, <nil>; want error", c.format, c.date, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestValueFormat_RoundTrip(t *testing.T) {
+	cases := []struct {
+		value ValueFormat
+		scan  ScanFormat
+	}{
+		{ValueString, ScanText},
+		{ValueTime, ScanTime},
+		{ValueYYYYMMDD, ScanYYYYMMDD},
+		{ValueUnixDays, ScanUnixDays},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value.String(), func(t *testing.T) {
+			for _, d := range []Date{{1, 1, 1}, {1969, 12, 31}, {1970, 1, 1}, {2024, 2, 29}, {9999, 12, 31}} {
+				v, err := c.value.Valuer(d).Value()
+				if err != nil {
+					t.Fatalf("Value(%v): %v", d, err)
+				}
+
+				var got Date
+				if err := (ScanOptions{Formats: c.scan}).Scanner(&got).Scan(v); err != nil {
+					t.Fatalf("Scan(%#v): %v", v, err)
+				}
+
+				if got != d {
+					t.Errorf("Scan(Value(%v)) = %v", d, got)
+				}
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDefaultValueFormat(t *testing.T) {
+	defer func(f ValueFormat) { DefaultValueFormat = f }(DefaultValueFormat)
+	DefaultValueFormat = ValueTime
+
+	want := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	got, err := Date{2026, 10, 17}.Value()
+	if err != nil || got != want {
+		t.Errorf("Value() = %#v, %v; want %v, <nil>", got, err, want)
+	}
+
+	got, err = NullDateFrom(Date{2026, 10, 17}).Value()
+	if err != nil || got != want {
+		t.Errorf("NullDate.Value() = %#v, %v; want %v, <nil>", got, err, want)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDateAs(t *testing.T) {
+	d := Date{2026, 10, 17}
+	midnight := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		in    driver.Valuer
+		out   sql.Scanner
+		value driver.Value
+	}{
+		{"DateAsTime", DateAsTime{d}, &DateAsTime{}, midnight},
+		{"DateAsYYYYMMDD", DateAsYYYYMMDD{d}, &DateAsYYYYMMDD{}, int64(20261017)},
+		{"DateAsUnixDays", DateAsUnixDays{d}, &DateAsUnixDays{}, int64(20743)},
+		{"NullDateAsTime", NullDateAsTime{NullDateFrom(d)}, &NullDateAsTime{}, midnight},
+		{"NullDateAsYYYYMMDD", NullDateAsYYYYMMDD{NullDateFrom(d)}, &NullDateAsYYYYMMDD{}, int64(20261017)},
+		{"NullDateAsUnixDays", NullDateAsUnixDays{NullDateFrom(d)}, &NullDateAsUnixDays{}, int64(20743)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := c.in.Value()
+			if err != nil || v != c.value {
+				t.Fatalf("Value() = %#v, %v; want %#v", v, err, c.value)
+			}
+
+			if err := c.out.Scan(v); err != nil {
+				t.Fatalf("Scan(%#v): %v", v, err)
+			}
+
+			if got := reflect.ValueOf(c.out).Elem().Interface(); got != c.in {
+				t.Errorf("Scan(%#v) = %v; want %v", v, got, c.in)
+			}
+
+			if err := c.out.Scan("2026-10-17"); err == nil {
+				t.Error("Scan(\"2026-10-17\") = <nil>; want error")
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestNullDateAs_Null(t *testing.T) {
+	for _, c := range []struct {
+		in  driver.Valuer
+		out sql.Scanner
+	}{
+		{NullDateAsTime{}, &NullDateAsTime{NullDateFrom(Date{2026, 10, 17})}},
+		{NullDateAsYYYYMMDD{}, &NullDateAsYYYYMMDD{NullDateFrom(Date{2026, 10, 17})}},
+		{NullDateAsUnixDays{}, &NullDateAsUnixDays{NullDateFrom(Date{2026, 10, 17})}},
+	} {
+		if v, err := c.in.Value(); err != nil || v != nil {
+			t.Errorf("%T.Value() = %#v, %v; want <nil>, <nil>", c.in, v, err)
+		}
+
+		if err := c.out.Scan(nil); err != nil {
+			t.Fatalf("%T.Scan(nil): %v", c.out, err)
+		}
+
+		if got := reflect.ValueOf(c.out).Elem().Interface(); got != c.in {
+			t.Errorf("%T.Scan(nil) = %v; want null", c.out, got)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package date
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// DateVector is a column of dates for batch work. Its operations change the
+// vector in place or write into a slice the caller provides, and do not
+// allocate once that slice is large enough.
+type DateVector []Packed
+
+// NewDateVector packs dates into a new vector.
+func NewDateVector(dates []Date) (DateVector, error) {
+	v := make(DateVector, len(dates))
+	for i, d := range dates {
+		p, err := d.Pack()
+		if err != nil {
+			return nil, err
+		}
+		v[i] = p
+	}
+
+	return v, nil
+}
+
+// AppendDates appends the dates of v to dst.
+func (v DateVector) AppendDates(dst []Date) []Date {
+	for _, p := range v {
+		dst = append(dst, p.Date())
+	}
+
+	return dst
+}
+
+// AddDays adds days to every date, like Packed.AddDays.
+func (v DateVector) AddDays(days int) {
+	for i, p := range v {
+		v[i] = p.AddDays(days)
+	}
+}
+
+// AddMonths adds months to every date, like Packed.AddMonths.
+func (v DateVector) AddMonths(months int) {
+	for i, p := range v {
+		v[i] = p.AddMonths(months)
+	}
+}
+
+// TruncateToMonth moves every date to the first of its month. It is fastest
+// when dates of the same month are next to each other.
+func (v DateVector) TruncateToMonth() {
+	// [first, next) is the month of the last date converted.
+	first, next := Packed(1), Packed(0)
+	for i, p := range v {
+		if p.IsInfinite() {
+			continue
+		}
+
+		if p < first || p >= next {
+			d := fromDaysSinceEpoch(int(p))
+			first = p - Packed(d.Day-1)
+			next = first + Packed(daysInMonth(d.Year, d.Month))
+		}
+		v[i] = first
+	}
+}
+
+// Weekdays writes the weekday of each date to dst, growing it only if it is
+// shorter than v, and returns dst[:len(v)].
+func (v DateVector) Weekdays(dst []time.Weekday) []time.Weekday {
+	dst = grow(dst, len(v))
+	for i, p := range v {
+		dst[i] = p.Weekday()
+	}
+
+	return dst
+}
+
+// Before writes whether each date is before cutoff to dst, growing it only if
+// it is shorter than v, and returns dst[:len(v)].
+func (v DateVector) Before(cutoff Packed, dst []bool) []bool {
+	dst = grow(dst, len(v))
+	for i, p := range v {
+		dst[i] = p < cutoff
+	}
+
+	return dst
+}
+
+// After is like Before for dates after cutoff.
+func (v DateVector) After(cutoff Packed, dst []bool) []bool {
+	dst = grow(dst, len(v))
+	for i, p := range v {
+		dst[i] = p > cutoff
+	}
+
+	return dst
+}
+
+func grow[T any](s []T, n int) []T {
+	if cap(s) < n {
+		return make([]T, n)
+	}
+	return s[:n]
+}
+
+// IsSorted reports whether the dates are in ascending order.
+func (v DateVector) IsSorted() bool {
+	for i := 1; i < len(v); i++ {
+		if v[i] < v[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+const vectorVersion byte = 1
+
+// DefaultMaxDateVectorLen is the most dates UnmarshalBinary decodes, 64 MiB of
+// Packed values. A few bytes of runs can describe any number of dates, so
+// untrusted input needs a limit; use a DateVectorDecoder for another one.
+const DefaultMaxDateVectorLen = 1 << 24
+
+// MarshalBinary compresses the vector as a version byte, the number of dates,
+// the first date and then runs of equal differences between consecutive
+// dates, each as the difference and the length of the run. Any vector can be
+// encoded, but sorted columns compress best: a year of consecutive days
+// takes under ten bytes.
+//
+// Vectors of any length can be encoded, but UnmarshalBinary only decodes up to
+// DefaultMaxDateVectorLen dates.
+func (v DateVector) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(nil)
+}
+
+// AppendBinary appends the encoding of MarshalBinary to b.
+func (v DateVector) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, vectorVersion)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	if len(v) == 0 {
+		return b, nil
+	}
+
+	b = binary.AppendVarint(b, int64(v[0]))
+	for i := 1; i < len(v); {
+		delta := int64(v[i]) - int64(v[i-1])
+
+		run := 1
+		for i+run < len(v) && int64(v[i+run])-int64(v[i+run-1]) == delta {
+			run++
+		}
+
+		b = binary.AppendVarint(b, delta)
+		b = binary.AppendUvarint(b, uint64(run))
+		i += run
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary decodes the encoding of MarshalBinary, rejecting data that
+// claims more than DefaultMaxDateVectorLen dates.
+func (v *DateVector) UnmarshalBinary(data []byte) error {
+	out, err := DateVectorDecoder{}.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	*v = out
+	return nil
+}
+
+// DateVectorDecoder decodes the encoding of DateVector.MarshalBinary with a
+// limit on the number of dates.
+type DateVectorDecoder struct {
+	// MaxLen is the most dates decoded. Zero means DefaultMaxDateVectorLen.
+	MaxLen int
+}
+
+func (dec DateVectorDecoder) Decode(data []byte) (DateVector, error) {
+	if len(data) == 0 {
+		return nil, errors.New("DateVectorDecoder.Decode: no data")
+	}
+
+	if data[0] != vectorVersion {
+		return nil, fmt.Errorf("DateVectorDecoder.Decode: unsupported version %d", data[0])
+	}
+	data = data[1:]
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, errors.New("DateVectorDecoder.Decode: invalid length")
+	}
+	if maxLen := dec.maxLen(); count > uint64(maxLen) {
+		return nil, fmt.Errorf("DateVectorDecoder.Decode: %d dates exceed the limit of %d", count, maxLen)
+	}
+	data = data[n:]
+
+	if count == 0 {
+		if len(data) != 0 {
+			return nil, errors.New("DateVectorDecoder.Decode: trailing data")
+		}
+		return DateVector{}, nil
+	}
+
+	first, n := binary.Varint(data)
+	if n <= 0 || first < math.MinInt32 || first > math.MaxInt32 {
+		return nil, errors.New("DateVectorDecoder.Decode: invalid first date")
+	}
+	data = data[n:]
+
+	// Runs must still deliver the count, so it only sizes the initial
+	// allocation up to a limit.
+	size := count
+	if size > 1<<16 {
+		size = 1 << 16
+	}
+
+	out := make(DateVector, 1, size)
+	out[0] = Packed(first)
+
+	for prev := first; uint64(len(out)) < count; {
+		delta, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, errors.New("DateVectorDecoder.Decode: invalid difference")
+		}
+		data = data[n:]
+
+		run, n := binary.Uvarint(data)
+		if n <= 0 || run == 0 || run > count-uint64(len(out)) {
+			return nil, errors.New("DateVectorDecoder.Decode: invalid run")
+		}
+		data = data[n:]
+
+		for ; run > 0; run-- {
+			if (delta > 0 && prev > math.MaxInt32-delta) || (delta < 0 && prev < math.MinInt32-delta) {
+				return nil, errors.New("DateVectorDecoder.Decode: date out of range")
+			}
+			prev += delta
+			out = append(out, Packed(prev))
+		}
+	}
+
+	if len(data) != 0 {
+		return nil, errors.New("DateVectorDecoder.Decode: trailing data")
+	}
+
+	return out, nil
+}
+
+func (dec DateVectorDecoder) maxLen() int {
+	if dec.MaxLen <= 0 {
+		return DefaultMaxDateVectorLen
+	}
+	return dec.MaxLen
+}
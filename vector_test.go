@@ -0,0 +1,332 @@
+package date_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDateVector_Ops(t *testing.T) {
+	dates := []Date{{2026, 1, 31}, {2024, 2, 29}, {2026, 10, 17}, Infinity, NegInfinity}
+
+	v, err := NewDateVector(dates)
+	if err != nil {
+		t.Fatalf("NewDateVector(): %v", err)
+	}
+
+	cases := []struct {
+		name string
+		op   func(DateVector)
+		each func(Date) Date
+	}{
+		{"AddDays", func(v DateVector) { v.AddDays(45) }, func(d Date) Date { return d.AddDays(45) }},
+		{"AddMonths", func(v DateVector) { v.AddMonths(-13) }, func(d Date) Date { return d.AddMonths(-13) }},
+		{"TruncateToMonth", DateVector.TruncateToMonth, Date.FirstOfMonth},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := append(DateVector(nil), v...)
+			c.op(got)
+
+			for i, d := range got.AppendDates(nil) {
+				want := dates[i]
+				if !want.IsInfinite() {
+					want = c.each(want)
+				}
+
+				if d != want {
+					t.Errorf("%s: [%d] = %v; want %v", c.name, i, d, want)
+				}
+			}
+		})
+	}
+
+	daily := dailyVector(400)
+	months := append(DateVector(nil), daily...)
+	months.TruncateToMonth()
+	for i, p := range months {
+		if want := daily[i].FirstOfMonth(); p != want {
+			t.Fatalf("TruncateToMonth()[%d] = %v; want %v", i, p, want)
+		}
+	}
+
+	weekdays := v.Weekdays(nil)
+	for i, d := range dates[:3] {
+		if weekdays[i] != d.Weekday() {
+			t.Errorf("Weekdays()[%d] = %v; want %v", i, weekdays[i], d.Weekday())
+		}
+	}
+
+	cutoff := MustPack(Date{2026, 1, 1})
+	if got, want := v.Before(cutoff, nil), []bool{false, true, false, false, true}; !equalBools(got, want) {
+		t.Errorf("Before() = %v; want %v", got, want)
+	}
+	if got, want := v.After(cutoff, nil), []bool{true, false, true, true, false}; !equalBools(got, want) {
+		t.Errorf("After() = %v; want %v", got, want)
+	}
+
+	if _, err := NewDateVector([]Date{{2026, 2, 30}}); err == nil {
+		t.Error("NewDateVector(2026-02-30) = <nil>; want error")
+	}
+}
+
+func equalBools(a, b []bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDateVector_NoAllocs(t *testing.T) {
+	v := dailyVector(1000)
+	weekdays := make([]time.Weekday, len(v))
+	before := make([]bool, len(v))
+	cutoff := v[len(v)/2]
+
+	allocs := testing.AllocsPerRun(10, func() {
+		v.AddDays(1)
+		v.AddMonths(1)
+		v.TruncateToMonth()
+		weekdays = v.Weekdays(weekdays)
+		before = v.Before(cutoff, before)
+	})
+
+	if allocs != 0 {
+		t.Errorf("batch operations allocated %v times; want 0", allocs)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDateVector_Binary(t *testing.T) {
+	cases := []struct {
+		name string
+		v    DateVector
+	}{
+		{"empty", DateVector{}},
+		{"one", DateVector{MustPack(Date{2026, 10, 17})}},
+		{"daily", dailyVector(365)},
+		{"unsorted", DateVector{5, -3, 100, 100, 100, 7}},
+		{"infinities", DateVector{PackedNegInfinity, 0, PackedInfinity, PackedNegInfinity}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := c.v.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary(): %v", err)
+			}
+
+			var got DateVector
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary(): %v", err)
+			}
+
+			if len(got) != len(c.v) {
+				t.Fatalf("UnmarshalBinary() = %v; want %v", got, c.v)
+			}
+			for i := range got {
+				if got[i] != c.v[i] {
+					t.Fatalf("UnmarshalBinary() = %v; want %v", got, c.v)
+				}
+			}
+
+			appended, err := c.v.AppendBinary([]byte("x"))
+			if err != nil || !bytes.Equal(appended[1:], data) {
+				t.Errorf("AppendBinary() = %x, %v; want x%x", appended, err, data)
+			}
+		})
+	}
+
+	if data, _ := dailyVector(365).MarshalBinary(); len(data) >= 10 {
+		t.Errorf("a year of days takes %d bytes; want fewer than 10", len(data))
+	}
+}
+
+func TestDateVector_UnmarshalBinaryErrors(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{2, 0},
+		{1},
+		{1, 0, 0},
+		{1, 2, 0},
+		{1, 2, 0, 2},
+		{1, 2, 0, 2, 0},
+		{1, 2, 0, 2, 2},
+		{1, 2, 0, 2, 1, 0},
+		{1, 2, 0xfe, 0xff, 0xff, 0xff, 0x0f, 2, 1},
+	} {
+		var v DateVector
+		if err := v.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%x) = %v, <nil>; want error", data, v)
+		}
+	}
+}
+
+func TestDateVector_UnmarshalBinaryHostile(t *testing.T) {
+	for _, count := range []uint64{DefaultMaxDateVectorLen + 1, 1 << 32, math.MaxUint64} {
+		// A first date and a single run of count-1 zero differences.
+		data := binary.AppendUvarint([]byte{1}, count)
+		data = binary.AppendVarint(data, 0)
+		data = binary.AppendVarint(data, 0)
+		data = binary.AppendUvarint(data, count-1)
+
+		var v DateVector
+		if err := v.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%x) decoded %d dates; want error", data, len(v))
+		}
+	}
+}
+
+func TestDateVectorDecoder(t *testing.T) {
+	data, err := dailyVector(11).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %v", err)
+	}
+
+	if v, err := (DateVectorDecoder{MaxLen: 10}).Decode(data); err == nil {
+		t.Errorf("Decode() with MaxLen 10 = %d dates, <nil>; want error", len(v))
+	}
+
+	if v, err := (DateVectorDecoder{MaxLen: 11}).Decode(data); err != nil || len(v) != 11 {
+		t.Errorf("Decode() with MaxLen 11 = %d dates, %v; want 11", len(v), err)
+	}
+
+	if testing.Short() {
+		return
+	}
+
+	// Encoding has no limit, so a vector past the default still round-trips
+	// with a larger one.
+	long := dailyVector(DefaultMaxDateVectorLen + 1)
+	if data, err = long.MarshalBinary(); err != nil {
+		t.Fatalf("MarshalBinary(%d dates): %v", len(long), err)
+	}
+
+	var v DateVector
+	if err := v.UnmarshalBinary(data); err == nil {
+		t.Errorf("UnmarshalBinary(%d dates) = <nil>; want error", len(long))
+	}
+
+	v, err = DateVectorDecoder{MaxLen: len(long)}.Decode(data)
+	if err != nil || len(v) != len(long) || v[len(v)-1] != long[len(long)-1] {
+		t.Errorf("Decode(%d dates) = %d dates, %v", len(long), len(v), err)
+	}
+}
+
+func dailyVector(n int) DateVector {
+	v := make(DateVector, n)
+	start := MustPack(Date{Year: 2026, Month: time.January, Day: 1})
+	for i := range v {
+		v[i] = start.AddDays(i)
+	}
+	return v
+}
+
+var benchmarkVectorSize = 100_000
+
+func BenchmarkDateVector_AddDays(b *testing.B) {
+	v := dailyVector(benchmarkVectorSize)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		v.AddDays(1)
+	}
+}
+
+func BenchmarkDateSlice_AddDays(b *testing.B) {
+	dates := dailyVector(benchmarkVectorSize).AppendDates(nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, d := range dates {
+			dates[j] = d.AddDays(1)
+		}
+	}
+}
+
+func BenchmarkDateVector_TruncateToMonth(b *testing.B) {
+	v := dailyVector(benchmarkVectorSize)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		v.TruncateToMonth()
+	}
+}
+
+func BenchmarkDateSlice_FirstOfMonth(b *testing.B) {
+	dates := dailyVector(benchmarkVectorSize).AppendDates(nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, d := range dates {
+			dates[j] = d.FirstOfMonth()
+		}
+	}
+}
+
+func BenchmarkDateVector_Weekdays(b *testing.B) {
+	v := dailyVector(benchmarkVectorSize)
+	dst := make([]time.Weekday, len(v))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst = v.Weekdays(dst)
+	}
+}
+
+func BenchmarkDateSlice_Weekday(b *testing.B) {
+	dates := dailyVector(benchmarkVectorSize).AppendDates(nil)
+	dst := make([]time.Weekday, len(dates))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, d := range dates {
+			dst[j] = d.Weekday()
+		}
+	}
+}
+
+func BenchmarkDateVector_Before(b *testing.B) {
+	v := dailyVector(benchmarkVectorSize)
+	cutoff := v[len(v)/2]
+	dst := make([]bool, len(v))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dst = v.Before(cutoff, dst)
+	}
+}
+
+func BenchmarkDateSlice_IsBefore(b *testing.B) {
+	dates := dailyVector(benchmarkVectorSize).AppendDates(nil)
+	cutoff := dates[len(dates)/2]
+	dst := make([]bool, len(dates))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, d := range dates {
+			dst[j] = d.IsBefore(cutoff)
+		}
+	}
+}
+
+func BenchmarkDateVector_MarshalBinary(b *testing.B) {
+	v := dailyVector(benchmarkVectorSize)
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf, _ = v.AppendBinary(buf[:0])
+	}
+}
@@ -0,0 +1,154 @@
+package date
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// pgEpoch is 2000-01-01, the zero of PostgreSQL's binary date.
+var pgEpoch = daysSinceEpoch(2000, time.January, 1)
+
+// sqlServerEpoch is 0001-01-01, the zero of SQL Server's binary date.
+var sqlServerEpoch = daysSinceEpoch(1, time.January, 1)
+
+// AppendPGBinary appends d in PostgreSQL's binary date format: a big-endian
+// int32 counting days since 2000-01-01, with Infinity and NegInfinity as the
+// largest and smallest int32. Dates that are not valid are rejected.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) AppendPGBinary(b []byte) ([]byte, error) {
+	var days int64
+	switch d {
+	case Infinity:
+		days = math.MaxInt32
+	case NegInfinity:
+		days = math.MinInt32
+	default:
+		if !d.IsValid() {
+			return b, fmt.Errorf("AppendPGBinary: date %v is not valid", d)
+		}
+
+		// int32 days span about 5.9 million years; checking the year first
+		// keeps daysSinceEpoch from overflowing.
+		if d.Year < -6_000_000 || d.Year > 6_000_000 {
+			return b, fmt.Errorf("AppendPGBinary: date %v out of range", d)
+		}
+
+		days = int64(daysSinceEpoch(d.Year, d.Month, d.Day) - pgEpoch)
+		if days <= math.MinInt32 || days >= math.MaxInt32 {
+			return b, fmt.Errorf("AppendPGBinary: date %v out of range", d)
+		}
+	}
+
+	return binary.BigEndian.AppendUint32(b, uint32(int32(days))), nil
+}
+
+// DecodePGBinary decodes a date in PostgreSQL's binary format.
+func DecodePGBinary(data []byte) (Date, error) {
+	if len(data) != 4 {
+		return Date{}, fmt.Errorf("DecodePGBinary: expected 4 bytes, got %d", len(data))
+	}
+
+	switch days := int32(binary.BigEndian.Uint32(data)); days {
+	case math.MaxInt32:
+		return Infinity, nil
+	case math.MinInt32:
+		return NegInfinity, nil
+	default:
+		return fromDaysSinceEpoch(pgEpoch + int(days)), nil
+	}
+}
+
+// AppendMySQLBinary appends d as a DATE in MySQL's binary protocol: a length
+// byte of 4 followed by the year as a little-endian uint16, the month and the
+// day. The zero Date is written as MySQL's zero date, a length byte of 0;
+// other dates must be valid.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) AppendMySQLBinary(b []byte) ([]byte, error) {
+	if d == (Date{}) {
+		return append(b, 0), nil
+	}
+
+	if !d.IsValid() {
+		return b, fmt.Errorf("AppendMySQLBinary: date %v is not valid", d)
+	}
+
+	if d.Year < 0 || d.Year > 9999 {
+		return b, fmt.Errorf("AppendMySQLBinary: date %v out of range", d)
+	}
+
+	b = append(b, 4)
+	b = binary.LittleEndian.AppendUint16(b, uint16(d.Year))
+	return append(b, byte(d.Month), byte(d.Day)), nil
+}
+
+// DecodeMySQLBinary decodes a length-prefixed DATE in MySQL's binary protocol.
+// It also accepts the 7 and 11 byte DATETIME forms if their time of day is
+// zero. MySQL's zero date decodes as the zero Date.
+func DecodeMySQLBinary(data []byte) (Date, error) {
+	if len(data) == 0 || int(data[0]) != len(data)-1 {
+		return Date{}, fmt.Errorf("DecodeMySQLBinary: invalid length prefix")
+	}
+
+	switch data[0] {
+	case 0:
+		return Date{}, nil
+	case 4:
+	case 7, 11:
+		for _, c := range data[5:] {
+			if c != 0 {
+				return Date{}, fmt.Errorf("DecodeMySQLBinary: time of day is not midnight")
+			}
+		}
+	default:
+		return Date{}, fmt.Errorf("DecodeMySQLBinary: unexpected length %d", data[0])
+	}
+
+	year := int(binary.LittleEndian.Uint16(data[1:3]))
+	if year == 0 && data[3] == 0 && data[4] == 0 {
+		return Date{}, nil
+	}
+
+	d, err := New(year, time.Month(data[3]), int(data[4]))
+	if err != nil {
+		return Date{}, fmt.Errorf("DecodeMySQLBinary: %w", err)
+	}
+
+	return d, nil
+}
+
+// AppendSQLServerBinary appends d in SQL Server's date format: three
+// little-endian bytes counting days since 0001-01-01. Dates that are not
+// valid, before 0001-01-01 or after 9999-12-31 are rejected.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) AppendSQLServerBinary(b []byte) ([]byte, error) {
+	if !d.IsValid() {
+		return b, fmt.Errorf("AppendSQLServerBinary: date %v is not valid", d)
+	}
+
+	if d.Year < 1 || d.Year > 9999 {
+		return b, fmt.Errorf("AppendSQLServerBinary: date %v out of range", d)
+	}
+
+	days := daysSinceEpoch(d.Year, d.Month, d.Day) - sqlServerEpoch
+	return append(b, byte(days), byte(days>>8), byte(days>>16)), nil
+}
+
+// DecodeSQLServerBinary decodes a date in SQL Server's format.
+func DecodeSQLServerBinary(data []byte) (Date, error) {
+	if len(data) != 3 {
+		return Date{}, fmt.Errorf("DecodeSQLServerBinary: expected 3 bytes, got %d", len(data))
+	}
+
+	days := int(data[0]) | int(data[1])<<8 | int(data[2])<<16
+	d := fromDaysSinceEpoch(sqlServerEpoch + days)
+	if d.Year > 9999 {
+		return Date{}, fmt.Errorf("DecodeSQLServerBinary: %d days is after 9999-12-31", days)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,242 @@
+package date_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	. "github.com/beonode/date"
+)
+
+type wireCodec struct {
+	name   string
+	append func(Date, []byte) ([]byte, error)
+	decode func([]byte) (Date, error)
+}
+
+var wireCodecs = []wireCodec{
+	{"Postgres", Date.AppendPGBinary, DecodePGBinary},
+	{"MySQL", Date.AppendMySQLBinary, DecodeMySQLBinary},
+	{"SQLServer", Date.AppendSQLServerBinary, DecodeSQLServerBinary},
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestWire_Encode(t *testing.T) {
+	cases := []struct {
+		codec string
+		date  Date
+		want  string
+	}{
+		{"Postgres", Date{2000, 1, 1}, "00000000"},
+		{"Postgres", Date{2000, 1, 2}, "00000001"},
+		{"Postgres", Date{1999, 12, 31}, "ffffffff"},
+		{"Postgres", Date{2026, 10, 17}, "0000263a"},
+		{"Postgres", Date{1970, 1, 1}, "ffffd533"},
+		{"Postgres", Infinity, "7fffffff"},
+		{"Postgres", NegInfinity, "80000000"},
+		{"MySQL", Date{2026, 10, 17}, "04ea070a11"},
+		{"MySQL", Date{1000, 1, 1}, "04e8030101"},
+		{"MySQL", Date{}, "00"},
+		{"SQLServer", Date{1, 1, 1}, "000000"},
+		{"SQLServer", Date{1900, 1, 1}, "5b950a"},
+		{"SQLServer", Date{9999, 12, 31}, "dab937"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.codec+" "+c.date.String(), func(t *testing.T) {
+			codec := findCodec(t, c.codec)
+
+			got, err := codec.append(c.date, []byte{0xAA})
+			if err != nil {
+				t.Fatalf("Append(%v): %v", c.date, err)
+			}
+
+			if h := hex.EncodeToString(got[1:]); got[0] != 0xAA || h != c.want {
+				t.Errorf("Append(%v) = %x; want aa%s", c.date, got, c.want)
+			}
+
+			decoded, err := codec.decode(got[1:])
+			if err != nil {
+				t.Fatalf("Decode(%x): %v", got[1:], err)
+			}
+
+			if decoded != c.date {
+				t.Errorf("Decode(%x) = %v; want %v", got[1:], decoded, c.date)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestWire_EncodeErrors(t *testing.T) {
+	cases := []struct {
+		codec string
+		date  Date
+	}{
+		{"Postgres", Date{6_000_000, 1, 1}},
+		{"Postgres", Date{-6_000_000, 1, 1}},
+		{"Postgres", Date{1 << 40, 1, 1}},
+		{"Postgres", Date{2026, 2, 30}},
+		{"Postgres", Date{2026, 13, 1}},
+		{"Postgres", Date{}},
+		{"MySQL", Date{10000, 1, 1}},
+		{"MySQL", Date{-1, 1, 1}},
+		{"MySQL", Infinity},
+		{"MySQL", Date{2026, 2, 30}},
+		{"MySQL", Date{2026, 13, 1}},
+		{"MySQL", Date{2026, 0, 0}},
+		{"SQLServer", Date{0, 12, 31}},
+		{"SQLServer", Date{10000, 1, 1}},
+		{"SQLServer", NegInfinity},
+		{"SQLServer", Date{2026, 2, 30}},
+		{"SQLServer", Date{2026, 13, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.codec+" "+c.date.String(), func(t *testing.T) {
+			codec := findCodec(t, c.codec)
+			if got, err := codec.append(c.date, nil); err == nil {
+				t.Errorf("Append(%v) = %x, <nil>; want error", c.date, got)
+			}
+		})
+	}
+}
+
+func TestWire_DecodeErrors(t *testing.T) {
+	cases := []struct {
+		codec string
+		data  string
+	}{
+		{"Postgres", ""},
+		{"Postgres", "000000"},
+		{"Postgres", "0000000000"},
+		{"MySQL", ""},
+		{"MySQL", "04ea070a"},
+		{"MySQL", "03ea070a"},
+		{"MySQL", "04ea070d01"},
+		{"MySQL", "04ea070230"},
+		{"MySQL", "07ea070a110c0000"},
+		{"SQLServer", "0000"},
+		{"SQLServer", "00000000"},
+		{"SQLServer", "ffffff"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.codec+" "+c.data, func(t *testing.T) {
+			codec := findCodec(t, c.codec)
+			data, _ := hex.DecodeString(c.data)
+			if got, err := codec.decode(data); err == nil {
+				t.Errorf("Decode(%s) = %v, <nil>; want error", c.data, got)
+			}
+		})
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDecodeMySQLBinary_DateTime(t *testing.T) {
+	cases := []string{"07ea070a11000000", "0bea070a1100000000000000"}
+	for _, c := range cases {
+		data, _ := hex.DecodeString(c)
+		got, err := DecodeMySQLBinary(data)
+		if err != nil {
+			t.Fatalf("DecodeMySQLBinary(%s): %v", c, err)
+		}
+
+		if want := (Date{2026, 10, 17}); got != want {
+			t.Errorf("DecodeMySQLBinary(%s) = %v; want %v", c, got, want)
+		}
+	}
+
+	zero, err := DecodeMySQLBinary([]byte{4, 0, 0, 0, 0})
+	if err != nil || zero != (Date{}) {
+		t.Errorf("DecodeMySQLBinary(zero date) = %v, %v; want zero Date, <nil>", zero, err)
+	}
+}
+
+// TestWire_RoundTrip encodes and decodes every date from 0001-01-01 to
+// 9999-12-31 with each codec, and checks that the Postgres encodings sort like
+// the dates.
+//
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestWire_RoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping exhaustive round trip in short mode")
+	}
+
+	for _, codec := range wireCodecs {
+		t.Run(codec.name, func(t *testing.T) {
+			var prev []byte
+			for d := (Date{1, 1, 1}); !d.IsAfter(Date{9999, 12, 31}); d = nextDay(d) {
+				data, err := codec.append(d, nil)
+				if err != nil {
+					t.Fatalf("Append(%v): %v", d, err)
+				}
+
+				got, err := codec.decode(data)
+				if err != nil {
+					t.Fatalf("Decode(%x) for %v: %v", data, d, err)
+				}
+
+				if got != d {
+					t.Fatalf("Decode(Append(%v)) = %v", d, got)
+				}
+
+				if codec.name == "Postgres" && prev != nil && bytes.Compare(flipSign(prev), flipSign(data)) >= 0 {
+					t.Fatalf("encoding of %v does not sort after its predecessor", d)
+				}
+				prev = data
+			}
+		})
+	}
+}
+
+func TestWire_PGRange(t *testing.T) {
+	for _, days := range []int32{-2147483647, -1, 0, 1, 2147483646} {
+		data := []byte{byte(uint32(days) >> 24), byte(uint32(days) >> 16), byte(uint32(days) >> 8), byte(uint32(days))}
+		d, err := DecodePGBinary(data)
+		if err != nil {
+			t.Fatalf("DecodePGBinary(%x): %v", data, err)
+		}
+
+		got, err := d.AppendPGBinary(nil)
+		if err != nil {
+			t.Fatalf("AppendPGBinary(%v): %v", d, err)
+		}
+
+		if !bytes.Equal(got, data) {
+			t.Errorf("AppendPGBinary(DecodePGBinary(%x)) = %x", data, got)
+		}
+	}
+}
+
+func findCodec(t *testing.T, name string) wireCodec {
+	t.Helper()
+
+	for _, c := range wireCodecs {
+		if c.name == name {
+			return c
+		}
+	}
+
+	t.Fatalf("unknown codec %s", name)
+	return wireCodec{}
+}
+
+// nextDay is a faster AddDays(1) for the exhaustive tests.
+func nextDay(d Date) Date {
+	if d.Day < d.LastOfMonth().Day {
+		d.Day++
+		return d
+	}
+
+	if d.Month < 12 {
+		return Date{Year: d.Year, Month: d.Month + 1, Day: 1}
+	}
+
+	return Date{Year: d.Year + 1, Month: 1, Day: 1}
+}
+
+// flipSign makes a big-endian two's complement integer sort as bytes.
+func flipSign(b []byte) []byte {
+	return append([]byte{b[0] ^ 0x80}, b[1:]...)
+}
@@ -0,0 +1,423 @@
+package date
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// XSDZone is the optional timezone suffix of the XML Schema date types: "Z",
+// an offset such as "+02:00", or nothing.
+type XSDZone struct {
+	Valid bool
+	// Offset is in minutes east of UTC.
+	Offset int
+}
+
+func (z XSDZone) String() string {
+	if !z.Valid {
+		return ""
+	}
+
+	if z.Offset == 0 {
+		return "Z"
+	}
+
+	sign, offset := '+', z.Offset
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/60, offset%60)
+}
+
+// Location returns a fixed zone for the offset, or nil if there is none.
+func (z XSDZone) Location() *time.Location {
+	if !z.Valid {
+		return nil
+	}
+
+	if z.Offset == 0 {
+		return time.UTC
+	}
+
+	return time.FixedZone(z.String(), z.Offset*60)
+}
+
+func parseXSDZone(s string) (XSDZone, error) {
+	switch {
+	case s == "":
+		return XSDZone{}, nil
+	case s == "Z":
+		return XSDZone{Valid: true}, nil
+	case len(s) == 6 && (s[0] == '+' || s[0] == '-') && s[3] == ':' && allDigits(s[1:3]) && allDigits(s[4:]):
+		hours, minutes := atoi(s[1:3]), atoi(s[4:])
+		if minutes > 59 || hours*60+minutes > 14*60 {
+			return XSDZone{}, fmt.Errorf("timezone %q out of range", s)
+		}
+
+		offset := hours*60 + minutes
+		if s[0] == '-' {
+			offset = -offset
+		}
+
+		return XSDZone{Valid: true, Offset: offset}, nil
+	}
+
+	return XSDZone{}, fmt.Errorf("invalid timezone %q", s)
+}
+
+// XSDDate is an xsd:date value such as "2026-10-17" or "2026-10-17+02:00"
+// that keeps its timezone suffix.
+type XSDDate struct {
+	Date Date
+	Zone XSDZone
+}
+
+func ParseXSDDate(value string) (XSDDate, error) {
+	year, rest, err := parseXSDYear(value)
+	if err != nil {
+		return XSDDate{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	if len(rest) < 6 || rest[0] != '-' || rest[3] != '-' || !allDigits(rest[1:3]) || !allDigits(rest[4:6]) {
+		return XSDDate{}, &ParseError{Value: value, Message: "expected -MM-DD after year"}
+	}
+
+	d, err := New(year, time.Month(atoi(rest[1:3])), atoi(rest[4:6]))
+	if err != nil {
+		return XSDDate{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	zone, err := parseXSDZone(rest[6:])
+	if err != nil {
+		return XSDDate{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	return XSDDate{d, zone}, nil
+}
+
+func (x XSDDate) String() string {
+	return formatXSDYear(x.Date.Year) + fmt.Sprintf("-%02d-%02d", x.Date.Month, x.Date.Day) + x.Zone.String()
+}
+
+// MarshalText returns an error for Infinity and NegInfinity, which xsd:date
+// cannot represent.
+func (x XSDDate) MarshalText() ([]byte, error) {
+	if x.Date.IsInfinite() {
+		return nil, fmt.Errorf("date %v cannot be represented as xsd:date", x.Date)
+	}
+	return []byte(x.String()), nil
+}
+
+func (x *XSDDate) UnmarshalText(data []byte) error {
+	v, err := ParseXSDDate(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+
+	*x = v
+	return nil
+}
+
+// GYearMonth is an xsd:gYearMonth value such as "2026-10".
+type GYearMonth struct {
+	Year  int
+	Month time.Month
+	Zone  XSDZone
+}
+
+func ParseGYearMonth(value string) (GYearMonth, error) {
+	year, rest, err := parseXSDYear(value)
+	if err != nil {
+		return GYearMonth{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	if len(rest) < 3 || rest[0] != '-' || !allDigits(rest[1:3]) {
+		return GYearMonth{}, &ParseError{Value: value, Message: "expected -MM after year"}
+	}
+
+	month := time.Month(atoi(rest[1:3]))
+	if month < time.January || month > time.December {
+		return GYearMonth{}, &ParseError{Value: value, Message: fmt.Sprintf("month must be between 1-12 (inclusive), got %d", month)}
+	}
+
+	zone, err := parseXSDZone(rest[3:])
+	if err != nil {
+		return GYearMonth{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	return GYearMonth{year, month, zone}, nil
+}
+
+func (g GYearMonth) String() string {
+	return formatXSDYear(g.Year) + fmt.Sprintf("-%02d", g.Month) + g.Zone.String()
+}
+
+func (g GYearMonth) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GYearMonth) UnmarshalText(data []byte) error {
+	v, err := ParseGYearMonth(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+
+	*g = v
+	return nil
+}
+
+// GMonthDay is an xsd:gMonthDay value such as "--10-17". February 29 is
+// allowed.
+type GMonthDay struct {
+	Month time.Month
+	Day   int
+	Zone  XSDZone
+}
+
+func ParseGMonthDay(value string) (GMonthDay, error) {
+	if len(value) < 7 || value[:2] != "--" || value[4] != '-' || !allDigits(value[2:4]) || !allDigits(value[5:7]) {
+		return GMonthDay{}, &ParseError{Value: value, Message: "expected --MM-DD"}
+	}
+
+	// 2000 is a leap year, so this accepts February 29.
+	d, err := New(2000, time.Month(atoi(value[2:4])), atoi(value[5:7]))
+	if err != nil {
+		return GMonthDay{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	zone, err := parseXSDZone(value[7:])
+	if err != nil {
+		return GMonthDay{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	return GMonthDay{d.Month, d.Day, zone}, nil
+}
+
+func (g GMonthDay) String() string {
+	return fmt.Sprintf("--%02d-%02d", g.Month, g.Day) + g.Zone.String()
+}
+
+func (g GMonthDay) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GMonthDay) UnmarshalText(data []byte) error {
+	v, err := ParseGMonthDay(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+
+	*g = v
+	return nil
+}
+
+// GYear is an xsd:gYear value such as "2026".
+type GYear struct {
+	Year int
+	Zone XSDZone
+}
+
+func ParseGYear(value string) (GYear, error) {
+	year, rest, err := parseXSDYear(value)
+	if err != nil {
+		return GYear{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	zone, err := parseXSDZone(rest)
+	if err != nil {
+		return GYear{}, &ParseError{Value: value, Message: err.Error()}
+	}
+
+	return GYear{year, zone}, nil
+}
+
+func (g GYear) String() string {
+	return formatXSDYear(g.Year) + g.Zone.String()
+}
+
+func (g GYear) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GYear) UnmarshalText(data []byte) error {
+	v, err := ParseGYear(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+
+	*g = v
+	return nil
+}
+
+// parseXSDYear reads an XSD year: an optional minus sign and at least four
+// digits, without leading zeros when there are more than four.
+func parseXSDYear(s string) (int, string, error) {
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+
+	n := 0
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+
+	if n < 4 {
+		return 0, s, fmt.Errorf("year must have at least 4 digits")
+	}
+	if n > 4 && s[0] == '0' {
+		return 0, s, fmt.Errorf("year with more than 4 digits must not start with 0")
+	}
+	if n > 18 {
+		return 0, s, fmt.Errorf("year out of range")
+	}
+
+	year := atoi(s[:n])
+	if neg {
+		year = -year
+	}
+
+	return year, s[n:], nil
+}
+
+func formatXSDYear(year int) string {
+	return string(appendInt(nil, year, 4))
+}
+
+// MarshalXML encodes the date as an xsd:date without timezone. Infinity and
+// NegInfinity, which xsd:date lacks, are written "infinity" and "-infinity"
+// as in JSON and text.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.xmlString(), start)
+}
+
+// UnmarshalXML decodes an xsd:date, "infinity" or "-infinity". A timezone
+// suffix is accepted and dropped, keeping the date as written; use XSDDate to
+// keep it.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	date, err := parseXMLDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = date
+	return nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: d.xmlString()}, nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	date, err := parseXMLDate(attr.Value)
+	if err != nil {
+		return err
+	}
+
+	*d = date
+	return nil
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (d Date) xmlString() string {
+	if d.IsInfinite() {
+		return d.String()
+	}
+	return XSDDate{Date: d}.String()
+}
+
+func parseXMLDate(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	if d, ok := parseInfinity(s); ok {
+		return d, nil
+	}
+
+	x, err := ParseXSDDate(s)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return x.Date, nil
+}
+
+// MarshalXML omits the element for a null date.
+func (d NullDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !d.Valid {
+		return nil
+	}
+	return d.Date.MarshalXML(e, start)
+}
+
+// UnmarshalXML decodes an empty element, or one with xsi:nil="true", as a
+// null date.
+func (d *NullDate) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" || isXSINil(start) {
+		d.Valid = false
+		d.Date = Date{}
+		return nil
+	}
+
+	date, err := parseXMLDate(s)
+	if err != nil {
+		return err
+	}
+
+	d.Valid, d.Date = true, date
+	return nil
+}
+
+// MarshalXMLAttr omits the attribute for a null date.
+func (d NullDate) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !d.Valid {
+		return xml.Attr{}, nil
+	}
+	return d.Date.MarshalXMLAttr(name)
+}
+
+func (d *NullDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	if strings.TrimSpace(attr.Value) == "" {
+		d.Valid = false
+		d.Date = Date{}
+		return nil
+	}
+
+	if err := d.Date.UnmarshalXMLAttr(attr); err != nil {
+		return err
+	}
+
+	d.Valid = true
+	return nil
+}
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+func isXSINil(start xml.StartElement) bool {
+	for _, a := range start.Attr {
+		if a.Name.Space == xsiNamespace && a.Name.Local == "nil" {
+			v := strings.TrimSpace(a.Value)
+			return v == "true" || v == "1"
+		}
+	}
+
+	return false
+}
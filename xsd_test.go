@@ -0,0 +1,248 @@
+package date_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	. "github.com/beonode/date"
+)
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestParseXSDDate(t *testing.T) {
+	cases := []struct {
+		value string
+		want  XSDDate
+	}{
+		{"2026-10-17", XSDDate{Date{2026, 10, 17}, XSDZone{}}},
+		{"2026-10-17Z", XSDDate{Date{2026, 10, 17}, XSDZone{Valid: true}}},
+		{"2026-10-17+02:00", XSDDate{Date{2026, 10, 17}, XSDZone{Valid: true, Offset: 120}}},
+		{"2026-10-17-05:30", XSDDate{Date{2026, 10, 17}, XSDZone{Valid: true, Offset: -330}}},
+		{"2026-10-17+14:00", XSDDate{Date{2026, 10, 17}, XSDZone{Valid: true, Offset: 840}}},
+		{"-0044-03-15", XSDDate{Date{-44, 3, 15}, XSDZone{}}},
+		{"12026-10-17", XSDDate{Date{12026, 10, 17}, XSDZone{}}},
+		{"0000-02-29", XSDDate{Date{0, 2, 29}, XSDZone{}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			got, err := ParseXSDDate(c.value)
+			if err != nil {
+				t.Fatalf("ParseXSDDate(%q): %v", c.value, err)
+			}
+
+			if got != c.want {
+				t.Errorf("ParseXSDDate(%q) = %v; want %v", c.value, got, c.want)
+			}
+
+			if s := got.String(); s != c.value {
+				t.Errorf("%v.String() = %q; want %q", got, s, c.value)
+			}
+		})
+	}
+}
+
+func TestParseXSDDate_Errors(t *testing.T) {
+	cases := []string{
+		"",
+		"26-10-17",
+		"02026-10-17",
+		"2026-10-32",
+		"2026-02-29",
+		"2026-1-17",
+		"2026-10-17+2:00",
+		"2026-10-17+15:00",
+		"2026-10-17+02:60",
+		"2026-10-17 Z",
+		"2026-10-17T00:00:00",
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			if got, err := ParseXSDDate(c); err == nil {
+				t.Errorf("ParseXSDDate(%q) = %v, <nil>; want error", c, got)
+			}
+		})
+	}
+}
+
+func TestParseGYearMonth(t *testing.T) {
+	got, err := ParseGYearMonth("2026-10+02:00")
+	if err != nil {
+		t.Fatalf("ParseGYearMonth(): %v", err)
+	}
+
+	want := GYearMonth{Year: 2026, Month: time.October, Zone: XSDZone{Valid: true, Offset: 120}}
+	if got != want {
+		t.Errorf("ParseGYearMonth() = %v; want %v", got, want)
+	}
+	if got.String() != "2026-10+02:00" {
+		t.Errorf("String() = %q; want \"2026-10+02:00\"", got.String())
+	}
+
+	for _, invalid := range []string{"2026-13", "2026", "2026-1", "2026-10-17"} {
+		if _, err := ParseGYearMonth(invalid); err == nil {
+			t.Errorf("ParseGYearMonth(%q) = _, <nil>; want error", invalid)
+		}
+	}
+}
+
+func TestParseGMonthDay(t *testing.T) {
+	got, err := ParseGMonthDay("--02-29Z")
+	if err != nil {
+		t.Fatalf("ParseGMonthDay(): %v", err)
+	}
+
+	want := GMonthDay{Month: time.February, Day: 29, Zone: XSDZone{Valid: true}}
+	if got != want {
+		t.Errorf("ParseGMonthDay() = %v; want %v", got, want)
+	}
+	if got.String() != "--02-29Z" {
+		t.Errorf("String() = %q; want \"--02-29Z\"", got.String())
+	}
+
+	for _, invalid := range []string{"--02-30", "--13-01", "02-28", "--2-28"} {
+		if _, err := ParseGMonthDay(invalid); err == nil {
+			t.Errorf("ParseGMonthDay(%q) = _, <nil>; want error", invalid)
+		}
+	}
+}
+
+func TestParseGYear(t *testing.T) {
+	got, err := ParseGYear("-0044")
+	if err != nil {
+		t.Fatalf("ParseGYear(): %v", err)
+	}
+
+	if want := (GYear{Year: -44}); got != want {
+		t.Errorf("ParseGYear() = %v; want %v", got, want)
+	}
+	if got.String() != "-0044" {
+		t.Errorf("String() = %q; want \"-0044\"", got.String())
+	}
+
+	for _, invalid := range []string{"26", "2026-", "02026"} {
+		if _, err := ParseGYear(invalid); err == nil {
+			t.Errorf("ParseGYear(%q) = _, <nil>; want error", invalid)
+		}
+	}
+}
+
+func TestXSDZone_Location(t *testing.T) {
+	if l := (XSDZone{}).Location(); l != nil {
+		t.Errorf("XSDZone{}.Location() = %v; want nil", l)
+	}
+
+	ti := time.Date(2026, 10, 17, 0, 0, 0, 0, XSDZone{Valid: true, Offset: -330}.Location())
+	if _, offset := ti.Zone(); offset != -330*60 {
+		t.Errorf("offset = %d; want %d", offset, -330*60)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_XML(t *testing.T) {
+	type payment struct {
+		XMLName   xml.Name   `xml:"Pmt"`
+		Booked    Date       `xml:"bkd,attr"`
+		Value     Date       `xml:"ValDt"`
+		Settled   NullDate   `xml:"SttlmDt"`
+		Cancelled NullDate   `xml:"CxlDt"`
+		Period    GYearMonth `xml:"Prd"`
+		Execution XSDDate    `xml:"ReqdExctnDt"`
+		Optional  NullDate   `xml:"opt,attr"`
+	}
+
+	in := payment{
+		Booked:    Date{2026, 10, 16},
+		Value:     Date{2026, 10, 17},
+		Settled:   NullDateFrom(Date{2026, 10, 19}),
+		Period:    GYearMonth{Year: 2026, Month: time.October},
+		Execution: XSDDate{Date{2026, 10, 17}, XSDZone{Valid: true, Offset: 120}},
+	}
+
+	data, err := xml.Marshal(in)
+	if err != nil {
+		t.Fatalf("xml.Marshal(): %v", err)
+	}
+
+	want := `<Pmt bkd="2026-10-16"><ValDt>2026-10-17</ValDt><SttlmDt>2026-10-19</SttlmDt><Prd>2026-10</Prd><ReqdExctnDt>2026-10-17+02:00</ReqdExctnDt></Pmt>`
+	if string(data) != want {
+		t.Errorf("xml.Marshal() = %s; want %s", data, want)
+	}
+
+	var out payment
+	if err := xml.Unmarshal(data, &out); err != nil {
+		t.Fatalf("xml.Unmarshal(): %v", err)
+	}
+
+	out.XMLName = xml.Name{}
+	if out != in {
+		t.Errorf("xml.Unmarshal() = %v; want %v", out, in)
+	}
+}
+
+//goland:noinspection GoStructInitializationWithoutFieldNames
+func TestDate_UnmarshalXML_Zone(t *testing.T) {
+	type doc struct {
+		Date    Date     `xml:"d"`
+		Attr    Date     `xml:"a,attr"`
+		Null    NullDate `xml:"n"`
+		Nil     NullDate `xml:"nil"`
+		Missing NullDate `xml:"m"`
+	}
+
+	data := `<doc xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" a="2026-10-17Z">
+		<d> 2026-10-17-05:00 </d>
+		<n></n>
+		<nil xsi:nil="true"/>
+	</doc>`
+
+	out := doc{Null: NullDateFrom(Date{2000, 1, 1})}
+	if err := xml.Unmarshal([]byte(data), &out); err != nil {
+		t.Fatalf("xml.Unmarshal(): %v", err)
+	}
+
+	want := doc{Date: Date{2026, 10, 17}, Attr: Date{2026, 10, 17}}
+	if out != want {
+		t.Errorf("xml.Unmarshal() = %v; want %v", out, want)
+	}
+
+	var d Date
+	if err := xml.Unmarshal([]byte(`<d>2026-02-30</d>`), &d); err == nil {
+		t.Error("xml.Unmarshal(2026-02-30) = <nil>; want error")
+	}
+}
+
+func TestDate_XML_Infinity(t *testing.T) {
+	type period struct {
+		XMLName xml.Name `xml:"p"`
+		From    Date     `xml:"from,attr"`
+		To      Date     `xml:"to"`
+		Until   NullDate `xml:"until"`
+	}
+
+	in := period{From: NegInfinity, To: Infinity, Until: NullDateFrom(Infinity)}
+	data, err := xml.Marshal(in)
+	if err != nil {
+		t.Fatalf("xml.Marshal(): %v", err)
+	}
+
+	want := `<p from="-infinity"><to>infinity</to><until>infinity</until></p>`
+	if string(data) != want {
+		t.Errorf("xml.Marshal() = %s; want %s", data, want)
+	}
+
+	var out period
+	if err := xml.Unmarshal(data, &out); err != nil {
+		t.Fatalf("xml.Unmarshal(): %v", err)
+	}
+
+	out.XMLName = xml.Name{}
+	if out != in {
+		t.Errorf("xml.Unmarshal() = %v; want %v", out, in)
+	}
+
+	if _, err := (XSDDate{Date: Infinity}).MarshalText(); err == nil {
+		t.Error("XSDDate{Infinity}.MarshalText() = <nil>; want error")
+	}
+}